@@ -6,20 +6,178 @@ import (
 
 // Table defined by the HP MIB that contains the status of each Array Accelerator.
 const (
-	cpqDaAccelCntlrIndex      = "1.3.6.1.4.1.232.3.2.2.2.1.1"
-	cpqDaAccelBattery         = "1.3.6.1.4.1.232.3.2.2.2.1.6"
-	cpqDaAccelCondition       = "1.3.6.1.4.1.232.3.2.2.2.1.9"
-	cpqDaAccelSerialNumber    = "1.3.6.1.4.1.232.3.2.2.2.1.11"
-	cpqDaAccelFailedBatteries = "1.3.6.1.4.1.232.3.2.2.2.1.15"
+	cpqDaAccelCntlrIndex        = "1.3.6.1.4.1.232.3.2.2.2.1.1"
+	cpqDaAccelStatus            = "1.3.6.1.4.1.232.3.2.2.2.1.2"
+	cpqDaAccelErrCode           = "1.3.6.1.4.1.232.3.2.2.2.1.5"
+	cpqDaAccelBattery           = "1.3.6.1.4.1.232.3.2.2.2.1.6"
+	cpqDaAccelReadErrs          = "1.3.6.1.4.1.232.3.2.2.2.1.7"
+	cpqDaAccelWriteErrs         = "1.3.6.1.4.1.232.3.2.2.2.1.8"
+	cpqDaAccelCondition         = "1.3.6.1.4.1.232.3.2.2.2.1.9"
+	cpqDaAccelSerialNumber      = "1.3.6.1.4.1.232.3.2.2.2.1.11"
+	cpqDaAccelTotalMemory       = "1.3.6.1.4.1.232.3.2.2.2.1.12"
+	cpqDaAccelReadCachePercent  = "1.3.6.1.4.1.232.3.2.2.2.1.13"
+	cpqDaAccelWriteCachePercent = "1.3.6.1.4.1.232.3.2.2.2.1.14"
+	cpqDaAccelFailedBatteries   = "1.3.6.1.4.1.232.3.2.2.2.1.15"
+	cpqDaAccelBackupPowerSource = "1.3.6.1.4.1.232.3.2.2.2.1.16"
 )
 
-// ArrayAccelerator models an Array Accelerator in the HP MIB.
+// ArrayAcceleratorCacheStatus describes whether the write cache of an Array Accelerator is enabled.
+type ArrayAcceleratorCacheStatus int
+
+const (
+	// ArrayAcceleratorCacheStatusUnknown indicates that the cache status cannot be determined.
+	ArrayAcceleratorCacheStatusUnknown ArrayAcceleratorCacheStatus = -1
+	// ArrayAcceleratorCacheStatusOther indicates that the instrument agent does not recognize the cache status.
+	ArrayAcceleratorCacheStatusOther ArrayAcceleratorCacheStatus = 1
+	// ArrayAcceleratorCacheStatusInvalid indicates that the Array Accelerator is not present.
+	ArrayAcceleratorCacheStatusInvalid ArrayAcceleratorCacheStatus = 2
+	// ArrayAcceleratorCacheStatusEnabled indicates that the write cache is enabled.
+	ArrayAcceleratorCacheStatusEnabled ArrayAcceleratorCacheStatus = 3
+	// ArrayAcceleratorCacheStatusTemporarilyDisabled indicates that the write cache is disabled until the
+	// condition described by the error code is resolved.
+	ArrayAcceleratorCacheStatusTemporarilyDisabled ArrayAcceleratorCacheStatus = 4
+	// ArrayAcceleratorCacheStatusPermanentlyDisabled indicates that the write cache is disabled until the
+	// Array Accelerator is replaced.
+	ArrayAcceleratorCacheStatusPermanentlyDisabled ArrayAcceleratorCacheStatus = 5
+)
+
+// ArrayAcceleratorError describes why the write cache of an Array Accelerator is disabled.
+type ArrayAcceleratorError int
+
+const (
+	// ArrayAcceleratorErrorUnknown indicates that the error cannot be determined.
+	ArrayAcceleratorErrorUnknown ArrayAcceleratorError = -1
+	// ArrayAcceleratorErrorOther indicates that the instrument agent does not recognize the error.
+	ArrayAcceleratorErrorOther ArrayAcceleratorError = 1
+	// ArrayAcceleratorErrorNone indicates that there is no error. The HP MIB names this value "invalid".
+	ArrayAcceleratorErrorNone ArrayAcceleratorError = 2
+	// ArrayAcceleratorErrorBadConfig indicates that the Array Accelerator is configured for a different controller.
+	ArrayAcceleratorErrorBadConfig ArrayAcceleratorError = 3
+	// ArrayAcceleratorErrorLowBattery indicates that the backup power source is not sufficiently charged.
+	ArrayAcceleratorErrorLowBattery ArrayAcceleratorError = 4
+	// ArrayAcceleratorErrorDisableCommand indicates that the write cache was disabled by a command.
+	ArrayAcceleratorErrorDisableCommand ArrayAcceleratorError = 5
+	// ArrayAcceleratorErrorNoResources indicates that the controller lacks the resources to use the write cache.
+	ArrayAcceleratorErrorNoResources ArrayAcceleratorError = 6
+	// ArrayAcceleratorErrorNotConnected indicates that the Array Accelerator is not connected.
+	ArrayAcceleratorErrorNotConnected ArrayAcceleratorError = 7
+	// ArrayAcceleratorErrorBadMirrorData indicates that the mirrored cache data does not match.
+	ArrayAcceleratorErrorBadMirrorData ArrayAcceleratorError = 8
+	// ArrayAcceleratorErrorReadError indicates that the cache data could not be read.
+	ArrayAcceleratorErrorReadError ArrayAcceleratorError = 9
+	// ArrayAcceleratorErrorWriteError indicates that the cache data could not be written.
+	ArrayAcceleratorErrorWriteError ArrayAcceleratorError = 10
+	// ArrayAcceleratorErrorConfigCommand indicates that the write cache is disabled while the controller is reconfigured.
+	ArrayAcceleratorErrorConfigCommand ArrayAcceleratorError = 11
+	// ArrayAcceleratorErrorExpandInProgress indicates that the write cache is disabled while a logical drive is expanded.
+	ArrayAcceleratorErrorExpandInProgress ArrayAcceleratorError = 12
+	// ArrayAcceleratorErrorSnapshotInProgress indicates that the write cache is disabled while a snapshot is taken.
+	ArrayAcceleratorErrorSnapshotInProgress ArrayAcceleratorError = 13
+	// ArrayAcceleratorErrorRedundantLowBattery indicates that the backup power source of the redundant controller
+	// is not sufficiently charged.
+	ArrayAcceleratorErrorRedundantLowBattery ArrayAcceleratorError = 14
+	// ArrayAcceleratorErrorRedundantSizeMismatch indicates that the cache sizes of the redundant controllers differ.
+	ArrayAcceleratorErrorRedundantSizeMismatch ArrayAcceleratorError = 15
+	// ArrayAcceleratorErrorRedundantCacheFailure indicates that the cache of the redundant controller has failed.
+	ArrayAcceleratorErrorRedundantCacheFailure ArrayAcceleratorError = 16
+	// ArrayAcceleratorErrorExcessiveECCErrors indicates that the cache memory reported too many ECC errors.
+	ArrayAcceleratorErrorExcessiveECCErrors ArrayAcceleratorError = 17
+	// ArrayAcceleratorErrorADGEnablerMissing indicates that the RAID ADG enabler module is missing.
+	ArrayAcceleratorErrorADGEnablerMissing ArrayAcceleratorError = 18
+	// ArrayAcceleratorErrorPostECCErrors indicates that the cache memory reported ECC errors during POST.
+	ArrayAcceleratorErrorPostECCErrors ArrayAcceleratorError = 19
+	// ArrayAcceleratorErrorBatteryHotRemoved indicates that the battery was removed while the system was running.
+	ArrayAcceleratorErrorBatteryHotRemoved ArrayAcceleratorError = 20
+	// ArrayAcceleratorErrorCapacitorChargeLow indicates that the capacitor is not sufficiently charged.
+	ArrayAcceleratorErrorCapacitorChargeLow ArrayAcceleratorError = 21
+	// ArrayAcceleratorErrorNotEnoughBatteries indicates that too few batteries are installed.
+	ArrayAcceleratorErrorNotEnoughBatteries ArrayAcceleratorError = 22
+	// ArrayAcceleratorErrorCacheModuleNotSupported indicates that the cache module is not supported by the controller.
+	ArrayAcceleratorErrorCacheModuleNotSupported ArrayAcceleratorError = 23
+	// ArrayAcceleratorErrorBatteryNotSupported indicates that the battery is not supported by the controller.
+	ArrayAcceleratorErrorBatteryNotSupported ArrayAcceleratorError = 24
+	// ArrayAcceleratorErrorNoCapacitorAttached indicates that no capacitor is attached to the cache module.
+	ArrayAcceleratorErrorNoCapacitorAttached ArrayAcceleratorError = 25
+	// ArrayAcceleratorErrorCapBasedBackupFailed indicates that the cache could not be backed up to flash on capacitor power.
+	ArrayAcceleratorErrorCapBasedBackupFailed ArrayAcceleratorError = 26
+	// ArrayAcceleratorErrorCapBasedRestoreFailed indicates that the cache could not be restored from flash.
+	ArrayAcceleratorErrorCapBasedRestoreFailed ArrayAcceleratorError = 27
+	// ArrayAcceleratorErrorCapBasedModuleHWFailure indicates that the flash backed cache module has failed.
+	ArrayAcceleratorErrorCapBasedModuleHWFailure ArrayAcceleratorError = 28
+	// ArrayAcceleratorErrorCapacitorFailedToCharge indicates that the capacitor could not be charged.
+	ArrayAcceleratorErrorCapacitorFailedToCharge ArrayAcceleratorError = 29
+	// ArrayAcceleratorErrorCapacitorHotRemoved indicates that the capacitor was removed while the system was running.
+	ArrayAcceleratorErrorCapacitorHotRemoved ArrayAcceleratorError = 30
+	// ArrayAcceleratorErrorCacheModuleHotRemoved indicates that the cache module was removed while the system was running.
+	ArrayAcceleratorErrorCacheModuleHotRemoved ArrayAcceleratorError = 31
+)
+
+var arrayAcceleratorErrorIDMappings = map[string]ArrayAcceleratorError{
+	"1":  ArrayAcceleratorErrorOther,
+	"2":  ArrayAcceleratorErrorNone,
+	"3":  ArrayAcceleratorErrorBadConfig,
+	"4":  ArrayAcceleratorErrorLowBattery,
+	"5":  ArrayAcceleratorErrorDisableCommand,
+	"6":  ArrayAcceleratorErrorNoResources,
+	"7":  ArrayAcceleratorErrorNotConnected,
+	"8":  ArrayAcceleratorErrorBadMirrorData,
+	"9":  ArrayAcceleratorErrorReadError,
+	"10": ArrayAcceleratorErrorWriteError,
+	"11": ArrayAcceleratorErrorConfigCommand,
+	"12": ArrayAcceleratorErrorExpandInProgress,
+	"13": ArrayAcceleratorErrorSnapshotInProgress,
+	"14": ArrayAcceleratorErrorRedundantLowBattery,
+	"15": ArrayAcceleratorErrorRedundantSizeMismatch,
+	"16": ArrayAcceleratorErrorRedundantCacheFailure,
+	"17": ArrayAcceleratorErrorExcessiveECCErrors,
+	"18": ArrayAcceleratorErrorADGEnablerMissing,
+	"19": ArrayAcceleratorErrorPostECCErrors,
+	"20": ArrayAcceleratorErrorBatteryHotRemoved,
+	"21": ArrayAcceleratorErrorCapacitorChargeLow,
+	"22": ArrayAcceleratorErrorNotEnoughBatteries,
+	"23": ArrayAcceleratorErrorCacheModuleNotSupported,
+	"24": ArrayAcceleratorErrorBatteryNotSupported,
+	"25": ArrayAcceleratorErrorNoCapacitorAttached,
+	"26": ArrayAcceleratorErrorCapBasedBackupFailed,
+	"27": ArrayAcceleratorErrorCapBasedRestoreFailed,
+	"28": ArrayAcceleratorErrorCapBasedModuleHWFailure,
+	"29": ArrayAcceleratorErrorCapacitorFailedToCharge,
+	"30": ArrayAcceleratorErrorCapacitorHotRemoved,
+	"31": ArrayAcceleratorErrorCacheModuleHotRemoved,
+}
+
+// BackupPowerSource describes the kind of power source that preserves the cache of an Array Accelerator
+// during a power loss.
+type BackupPowerSource int
+
+const (
+	// BackupPowerSourceUnknown indicates that the backup power source cannot be determined.
+	BackupPowerSourceUnknown BackupPowerSource = -1
+	// BackupPowerSourceOther indicates that the instrument agent does not recognize the backup power source.
+	BackupPowerSourceOther BackupPowerSource = 1
+	// BackupPowerSourceBatteries indicates that the cache is preserved by batteries.
+	BackupPowerSourceBatteries BackupPowerSource = 2
+	// BackupPowerSourceCapacitors indicates that the cache is preserved by capacitors.
+	BackupPowerSourceCapacitors BackupPowerSource = 3
+)
+
+// ArrayAccelerator models an Array Accelerator in the HP MIB. The cache sizes and error counters are
+// set to -1, and the cache status and error code to their Unknown value, if they are not reported by
+// the agent.
 type ArrayAccelerator struct {
 	ID                 int
 	Status             Status
 	BatteryStatus      BatteryStatus
 	SerialNumber       string
-	FailedBatterySlots string
+	FailedBatterySlots []int
+	CacheStatus        ArrayAcceleratorCacheStatus
+	ErrorCode          ArrayAcceleratorError
+	ReadErrors         int
+	WriteErrors        int
+	TotalCacheMB       int
+	ReadCacheMB        int
+	WriteCacheMB       int
+	BackupPowerSource  BackupPowerSource
 }
 
 // ArrayAccelerators returns a list of Array Accelerators. Returns a non-nil error of the list of ArrayAccelerators
@@ -39,6 +197,21 @@ func (m *MIB) ArrayAccelerators() ([]ArrayAccelerator, error) {
 		return []ArrayAccelerator{}, err
 	}
 
+	optionalColumns := OIDList{
+		cpqDaAccelStatus,
+		cpqDaAccelErrCode,
+		cpqDaAccelReadErrs,
+		cpqDaAccelWriteErrs,
+		cpqDaAccelTotalMemory,
+		cpqDaAccelReadCachePercent,
+		cpqDaAccelWriteCachePercent,
+		cpqDaAccelBackupPowerSource,
+	}
+	optional, err := traverseOptionalColumns(m.snmpClient, optionalColumns)
+	if err != nil {
+		return []ArrayAccelerator{}, err
+	}
+
 	for _, row := range table {
 		index, err := strconv.Atoi(row[0])
 		if err != nil {
@@ -47,7 +220,42 @@ func (m *MIB) ArrayAccelerators() ([]ArrayAccelerator, error) {
 		status := parseStatus(row[1])
 		battStatus := parseBatteryStatus(row[2])
 		serialNo := prettifyString(row[3])
-		failedSlots := prettifyString(row[4])
+		failedSlots := parseFailedBatterySlots(row[4])
+		cacheStatus := parseArrayAcceleratorCacheStatus(optional[cpqDaAccelStatus][row[0]])
+		errCode := parseArrayAcceleratorError(optional[cpqDaAccelErrCode][row[0]])
+		readErrs, err := parseOptionalInt(optional[cpqDaAccelReadErrs], row[0])
+		if err != nil {
+			return []ArrayAccelerator{}, err
+		}
+		writeErrs, err := parseOptionalInt(optional[cpqDaAccelWriteErrs], row[0])
+		if err != nil {
+			return []ArrayAccelerator{}, err
+		}
+		// The total memory is reported in kilobytes and is split between the read and write cache
+		// by percentage.
+		totalMemory, err := parseOptionalInt(optional[cpqDaAccelTotalMemory], row[0])
+		if err != nil {
+			return []ArrayAccelerator{}, err
+		}
+		readPercent, err := parseOptionalInt(optional[cpqDaAccelReadCachePercent], row[0])
+		if err != nil {
+			return []ArrayAccelerator{}, err
+		}
+		writePercent, err := parseOptionalInt(optional[cpqDaAccelWriteCachePercent], row[0])
+		if err != nil {
+			return []ArrayAccelerator{}, err
+		}
+		totalCache, readCache, writeCache := -1, -1, -1
+		if totalMemory >= 0 {
+			totalCache = totalMemory / 1024
+			if readPercent >= 0 {
+				readCache = totalMemory * readPercent / 100 / 1024
+			}
+			if writePercent >= 0 {
+				writeCache = totalMemory * writePercent / 100 / 1024
+			}
+		}
+		backupPowerSource := parseBackupPowerSource(optional[cpqDaAccelBackupPowerSource][row[0]])
 
 		accelerators = append(accelerators, ArrayAccelerator{
 			ID:                 index,
@@ -55,6 +263,14 @@ func (m *MIB) ArrayAccelerators() ([]ArrayAccelerator, error) {
 			Status:             status,
 			SerialNumber:       serialNo,
 			BatteryStatus:      battStatus,
+			CacheStatus:        cacheStatus,
+			ErrorCode:          errCode,
+			ReadErrors:         readErrs,
+			WriteErrors:        writeErrs,
+			TotalCacheMB:       totalCache,
+			ReadCacheMB:        readCache,
+			WriteCacheMB:       writeCache,
+			BackupPowerSource:  backupPowerSource,
 		})
 	}
 
@@ -104,3 +320,156 @@ func (s *BatteryStatus) String() string {
 		return "Unknown"
 	}
 }
+
+// parseFailedBatterySlots returns the slots of the failed batteries from the given string, which
+// contains one octet per failed battery.
+func parseFailedBatterySlots(s string) []int {
+	slots := []int{}
+	for _, b := range []byte(s) {
+		slots = append(slots, int(b))
+	}
+	return slots
+}
+
+// parseArrayAcceleratorCacheStatus takes an SNMP value and determines the cache status as defined by the HP MIB.
+func parseArrayAcceleratorCacheStatus(s string) ArrayAcceleratorCacheStatus {
+	switch s {
+	case "1":
+		return ArrayAcceleratorCacheStatusOther
+	case "2":
+		return ArrayAcceleratorCacheStatusInvalid
+	case "3":
+		return ArrayAcceleratorCacheStatusEnabled
+	case "4":
+		return ArrayAcceleratorCacheStatusTemporarilyDisabled
+	case "5":
+		return ArrayAcceleratorCacheStatusPermanentlyDisabled
+	default:
+		return ArrayAcceleratorCacheStatusUnknown
+	}
+}
+
+// String converts the ArrayAcceleratorCacheStatus to a human readable string.
+func (s *ArrayAcceleratorCacheStatus) String() string {
+	switch *s {
+	case ArrayAcceleratorCacheStatusOther:
+		return "Other"
+	case ArrayAcceleratorCacheStatusInvalid:
+		return "Invalid"
+	case ArrayAcceleratorCacheStatusEnabled:
+		return "Enabled"
+	case ArrayAcceleratorCacheStatusTemporarilyDisabled:
+		return "Temporarily Disabled"
+	case ArrayAcceleratorCacheStatusPermanentlyDisabled:
+		return "Permanently Disabled"
+	default:
+		return "Unknown"
+	}
+}
+
+// parseArrayAcceleratorError takes an SNMP value and determines the error code as defined by the HP MIB.
+func parseArrayAcceleratorError(s string) ArrayAcceleratorError {
+	e, ok := arrayAcceleratorErrorIDMappings[s]
+	if !ok {
+		return ArrayAcceleratorErrorUnknown
+	}
+	return e
+}
+
+// String converts the ArrayAcceleratorError to a human readable string.
+func (e *ArrayAcceleratorError) String() string {
+	switch *e {
+	case ArrayAcceleratorErrorOther:
+		return "Other"
+	case ArrayAcceleratorErrorNone:
+		return "None"
+	case ArrayAcceleratorErrorBadConfig:
+		return "Bad Configuration"
+	case ArrayAcceleratorErrorLowBattery:
+		return "Low Battery"
+	case ArrayAcceleratorErrorDisableCommand:
+		return "Disable Command"
+	case ArrayAcceleratorErrorNoResources:
+		return "No Resources"
+	case ArrayAcceleratorErrorNotConnected:
+		return "Not Connected"
+	case ArrayAcceleratorErrorBadMirrorData:
+		return "Bad Mirror Data"
+	case ArrayAcceleratorErrorReadError:
+		return "Read Error"
+	case ArrayAcceleratorErrorWriteError:
+		return "Write Error"
+	case ArrayAcceleratorErrorConfigCommand:
+		return "Configuration Command"
+	case ArrayAcceleratorErrorExpandInProgress:
+		return "Expand In Progress"
+	case ArrayAcceleratorErrorSnapshotInProgress:
+		return "Snapshot In Progress"
+	case ArrayAcceleratorErrorRedundantLowBattery:
+		return "Redundant Low Battery"
+	case ArrayAcceleratorErrorRedundantSizeMismatch:
+		return "Redundant Size Mismatch"
+	case ArrayAcceleratorErrorRedundantCacheFailure:
+		return "Redundant Cache Failure"
+	case ArrayAcceleratorErrorExcessiveECCErrors:
+		return "Excessive ECC Errors"
+	case ArrayAcceleratorErrorADGEnablerMissing:
+		return "ADG Enabler Missing"
+	case ArrayAcceleratorErrorPostECCErrors:
+		return "POST ECC Errors"
+	case ArrayAcceleratorErrorBatteryHotRemoved:
+		return "Battery Hot Removed"
+	case ArrayAcceleratorErrorCapacitorChargeLow:
+		return "Capacitor Charge Low"
+	case ArrayAcceleratorErrorNotEnoughBatteries:
+		return "Not Enough Batteries"
+	case ArrayAcceleratorErrorCacheModuleNotSupported:
+		return "Cache Module Not Supported"
+	case ArrayAcceleratorErrorBatteryNotSupported:
+		return "Battery Not Supported"
+	case ArrayAcceleratorErrorNoCapacitorAttached:
+		return "No Capacitor Attached"
+	case ArrayAcceleratorErrorCapBasedBackupFailed:
+		return "Capacitor Based Backup Failed"
+	case ArrayAcceleratorErrorCapBasedRestoreFailed:
+		return "Capacitor Based Restore Failed"
+	case ArrayAcceleratorErrorCapBasedModuleHWFailure:
+		return "Capacitor Based Module Hardware Failure"
+	case ArrayAcceleratorErrorCapacitorFailedToCharge:
+		return "Capacitor Failed To Charge"
+	case ArrayAcceleratorErrorCapacitorHotRemoved:
+		return "Capacitor Hot Removed"
+	case ArrayAcceleratorErrorCacheModuleHotRemoved:
+		return "Cache Module Hot Removed"
+	default:
+		return "Unknown"
+	}
+}
+
+// parseBackupPowerSource takes an SNMP value and determines the backup power source as defined by the HP MIB.
+func parseBackupPowerSource(s string) BackupPowerSource {
+	switch s {
+	case "1":
+		return BackupPowerSourceOther
+	case "2":
+		return BackupPowerSourceBatteries
+	case "3":
+		return BackupPowerSourceCapacitors
+	default:
+		return BackupPowerSourceUnknown
+	}
+}
+
+// String converts the BackupPowerSource to a human readable string.
+func (b *BackupPowerSource) String() string {
+	switch *b {
+	case BackupPowerSourceOther:
+		return "Other"
+	case BackupPowerSourceBatteries:
+		return "Batteries"
+	case BackupPowerSourceCapacitors:
+		return "Capacitors"
+	default:
+		return "Unknown"
+	}
+}
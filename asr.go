@@ -0,0 +1,163 @@
+package hpmib
+
+// ASRState describes whether advanced server recovery is enabled.
+type ASRState int
+
+// ASRResetCause describes whether the last system reset was caused by advanced server recovery.
+type ASRResetCause int
+
+// ASR models the configuration and state of the advanced server recovery sub-system, which resets
+// the system if the operating system stops responding.
+type ASR struct {
+	// State describes whether advanced server recovery is enabled.
+	State ASRState
+	// TimeoutMinutes is the number of minutes the operating system may stop responding before the
+	// system is reset. Set to -1 if not reported by the agent.
+	TimeoutMinutes int
+	// POSTTimeoutEnabled is true if the system is reset when POST does not complete in time.
+	POSTTimeoutEnabled bool
+	// POSTTimeoutMinutes is the number of minutes POST may take before the system is reset.
+	// Set to -1 if not reported by the agent.
+	POSTTimeoutMinutes int
+	// ResetCount is the number of consecutive resets performed by advanced server recovery.
+	// Set to -1 if not reported by the agent.
+	ResetCount int
+	// ResetLimit is the number of consecutive resets advanced server recovery may perform before
+	// it stops resetting the system. Set to -1 if not reported by the agent.
+	ResetLimit int
+	// LastResetCause describes whether the last system reset was caused by advanced server recovery.
+	LastResetCause ASRResetCause
+	// Condition is the overall condition of the advanced server recovery sub-system.
+	Condition Status
+}
+
+// Scalars defined by the HP MIB that describe the advanced server recovery sub-system.
+const (
+	cpqHeAsrStatus      OID = "1.3.6.1.4.1.232.6.2.5.1"
+	cpqHeAsrTimeout     OID = "1.3.6.1.4.1.232.6.2.5.4"
+	cpqHeAsrPost        OID = "1.3.6.1.4.1.232.6.2.5.7"
+	cpqHeAsrReset       OID = "1.3.6.1.4.1.232.6.2.5.8"
+	cpqHeAsrPostTimeout OID = "1.3.6.1.4.1.232.6.2.5.9"
+	cpqHeAsrResetCount  OID = "1.3.6.1.4.1.232.6.2.5.10"
+	cpqHeAsrResetLimit  OID = "1.3.6.1.4.1.232.6.2.5.11"
+)
+
+// ASR states defined by the HP MIB.
+const (
+	ASRStateUnknown      ASRState = -1
+	ASRStateOther        ASRState = 1
+	ASRStateNotAvailable ASRState = 2
+	ASRStateDisabled     ASRState = 3
+	ASRStateEnabled      ASRState = 4
+)
+
+// ASR reset causes defined by the HP MIB.
+const (
+	ASRResetCauseUnknown ASRResetCause = -1
+	ASRResetCauseOther   ASRResetCause = 1
+	ASRResetCauseNone    ASRResetCause = 2
+	ASRResetCauseASR     ASRResetCause = 3
+)
+
+var (
+	asrStateIDMappings = map[string]ASRState{
+		"1": ASRStateOther,
+		"2": ASRStateNotAvailable,
+		"3": ASRStateDisabled,
+		"4": ASRStateEnabled,
+	}
+	asrStateHumanMappings = map[ASRState]string{
+		ASRStateOther:        "Other",
+		ASRStateNotAvailable: "Not Available",
+		ASRStateDisabled:     "Disabled",
+		ASRStateEnabled:      "Enabled",
+	}
+	asrResetCauseIDMappings = map[string]ASRResetCause{
+		"1": ASRResetCauseOther,
+		"2": ASRResetCauseNone,
+		"3": ASRResetCauseASR,
+	}
+	asrResetCauseHumanMappings = map[ASRResetCause]string{
+		ASRResetCauseOther: "Other",
+		ASRResetCauseNone:  "Not Caused By ASR",
+		ASRResetCauseASR:   "Caused By ASR",
+	}
+)
+
+// ASR returns the configuration and state of the advanced server recovery sub-system.
+// Returns a non-nil error if the configuration could not be determined.
+func (m *MIB) ASR() (ASR, error) {
+	values, err := getScalars(m.snmpClient, OIDList{
+		cpqHeAsrStatus,
+		cpqHeAsrTimeout,
+		cpqHeAsrPost,
+		cpqHeAsrReset,
+		cpqHeAsrPostTimeout,
+		cpqHeAsrResetCount,
+		cpqHeAsrResetLimit,
+		cpqHeAsrCondition,
+	})
+	if err != nil {
+		return ASR{}, err
+	}
+	timeout, err := parseScalarInt(values[1])
+	if err != nil {
+		return ASR{}, err
+	}
+	postTimeout, err := parseScalarInt(values[4])
+	if err != nil {
+		return ASR{}, err
+	}
+	resetCount, err := parseScalarInt(values[5])
+	if err != nil {
+		return ASR{}, err
+	}
+	resetLimit, err := parseScalarInt(values[6])
+	if err != nil {
+		return ASR{}, err
+	}
+	return ASR{
+		State:              parseASRState(values[0]),
+		TimeoutMinutes:     timeout,
+		POSTTimeoutEnabled: values[2] == "3",
+		POSTTimeoutMinutes: postTimeout,
+		ResetCount:         resetCount,
+		ResetLimit:         resetLimit,
+		LastResetCause:     parseASRResetCause(values[3]),
+		Condition:          parseStatus(values[7]),
+	}, nil
+}
+
+func parseASRState(s string) ASRState {
+	state, ok := asrStateIDMappings[s]
+	if !ok {
+		return ASRStateUnknown
+	}
+	return state
+}
+
+// String converts the ASRState to a human readable string.
+func (a *ASRState) String() string {
+	s, ok := asrStateHumanMappings[*a]
+	if !ok {
+		return "Unknown"
+	}
+	return s
+}
+
+func parseASRResetCause(s string) ASRResetCause {
+	cause, ok := asrResetCauseIDMappings[s]
+	if !ok {
+		return ASRResetCauseUnknown
+	}
+	return cause
+}
+
+// String converts the ASRResetCause to a human readable string.
+func (a *ASRResetCause) String() string {
+	s, ok := asrResetCauseHumanMappings[*a]
+	if !ok {
+		return "Unknown"
+	}
+	return s
+}
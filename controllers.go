@@ -4,22 +4,243 @@ import (
 	"strconv"
 )
 
-// Controller models a storage controller in the HP MIB.
+// ControllerRole describes the role of a controller in a redundant pair of controllers.
+type ControllerRole int
+
+// ControllerBoardStatus describes the status of the board of a controller.
+type ControllerBoardStatus int
+
+// DriveWriteCacheState describes whether the write cache of the physical drives attached to a
+// controller is enabled.
+type DriveWriteCacheState int
+
+// ControllerEncryptionStatus describes whether a controller encrypts the data on its logical drives.
+type ControllerEncryptionStatus int
+
+// Controller models a storage controller in the HP MIB. CacheSizeMB is set to -1 and CacheSerialNo
+// is empty if the controller does not have a cache module. Model and FirmwareRev are empty, and the
+// remaining statuses are set to their Unknown value, if not reported by the agent.
 type Controller struct {
-	ID       int
-	SlotNo   int
-	SerialNo string
-	Status   Status
-	Location string
+	ID               int
+	SlotNo           int
+	SerialNo         string
+	Status           Status
+	Location         string
+	Model            string
+	FirmwareRev      string
+	BoardStatus      ControllerBoardStatus
+	BoardCondition   Status
+	Role             ControllerRole
+	DriveWriteCache  DriveWriteCacheState
+	EncryptionStatus ControllerEncryptionStatus
+	CacheSizeMB      int
+	CacheSerialNo    string
 }
 
 // Table defined by the HP MIB that contains the status of each controller.
 const (
-	cpqDaCntlrIndex        = "1.3.6.1.4.1.232.3.2.2.1.1.1"
-	cpqDaCntlrSlot         = "1.3.6.1.4.1.232.3.2.2.1.1.5"
-	cpqDaCntlrCondition    = "1.3.6.1.4.1.232.3.2.2.1.1.6"
-	cpqDaCntlrSerialNumber = "1.3.6.1.4.1.232.3.2.2.1.1.15"
-	cpqDaCntlrHwLocation   = "1.3.6.1.4.1.232.3.2.2.1.1.20"
+	cpqDaCntlrIndex                = "1.3.6.1.4.1.232.3.2.2.1.1.1"
+	cpqDaCntlrModel                = "1.3.6.1.4.1.232.3.2.2.1.1.2"
+	cpqDaCntlrFWRev                = "1.3.6.1.4.1.232.3.2.2.1.1.3"
+	cpqDaCntlrSlot                 = "1.3.6.1.4.1.232.3.2.2.1.1.5"
+	cpqDaCntlrCondition            = "1.3.6.1.4.1.232.3.2.2.1.1.6"
+	cpqDaCntlrCurrentRole          = "1.3.6.1.4.1.232.3.2.2.1.1.9"
+	cpqDaCntlrBoardStatus          = "1.3.6.1.4.1.232.3.2.2.1.1.10"
+	cpqDaCntlrBoardCondition       = "1.3.6.1.4.1.232.3.2.2.1.1.12"
+	cpqDaCntlrSerialNumber         = "1.3.6.1.4.1.232.3.2.2.1.1.15"
+	cpqDaCntlrHwLocation           = "1.3.6.1.4.1.232.3.2.2.1.1.20"
+	cpqDaCntlrDriveWriteCacheState = "1.3.6.1.4.1.232.3.2.2.1.1.27"
+	cpqDaCntlrEncryptionStatus     = "1.3.6.1.4.1.232.3.2.2.1.1.34"
+)
+
+// Controller roles defined by the HP MIB.
+const (
+	ControllerRoleUnknown     ControllerRole = -1
+	ControllerRoleOther       ControllerRole = 1
+	ControllerRoleNotDuplexed ControllerRole = 2
+	ControllerRoleActive      ControllerRole = 3
+	ControllerRoleBackup      ControllerRole = 4
+)
+
+// Controller board statuses defined by the HP MIB.
+const (
+	ControllerBoardStatusUnknown            ControllerBoardStatus = -1
+	ControllerBoardStatusOther              ControllerBoardStatus = 1
+	ControllerBoardStatusOK                 ControllerBoardStatus = 2
+	ControllerBoardStatusGeneralFailure     ControllerBoardStatus = 3
+	ControllerBoardStatusCableProblem       ControllerBoardStatus = 4
+	ControllerBoardStatusPoweredOff         ControllerBoardStatus = 5
+	ControllerBoardStatusCacheModuleMissing ControllerBoardStatus = 6
+)
+
+// Drive write cache states defined by the HP MIB.
+const (
+	DriveWriteCacheStateUnknown  DriveWriteCacheState = -1
+	DriveWriteCacheStateOther    DriveWriteCacheState = 1
+	DriveWriteCacheStateDisabled DriveWriteCacheState = 2
+	DriveWriteCacheStateEnabled  DriveWriteCacheState = 3
+)
+
+// Controller encryption statuses defined by the HP MIB.
+const (
+	ControllerEncryptionStatusUnknown      ControllerEncryptionStatus = -1
+	ControllerEncryptionStatusOther        ControllerEncryptionStatus = 1
+	ControllerEncryptionStatusEncrypted    ControllerEncryptionStatus = 2
+	ControllerEncryptionStatusNotEncrypted ControllerEncryptionStatus = 3
+)
+
+var (
+	// controllerModelMappings maps the controller model IDs defined by the HP MIB to their model names.
+	// ID 28 is not assigned.
+	controllerModelMappings = map[string]string{
+		"1":   "Other",
+		"2":   "Integrated Drive Array",
+		"3":   "Intelligent Drive Array Expansion",
+		"4":   "Intelligent Drive Array-2",
+		"5":   "SMART Array",
+		"6":   "SMART-2/E Array",
+		"7":   "SMART-2/P Array",
+		"8":   "SMART-2SL Array",
+		"9":   "Smart Array 3100ES",
+		"10":  "Smart Array 3200",
+		"11":  "SMART-2DH Array",
+		"12":  "Smart Array 221",
+		"13":  "Smart Array 4250ES",
+		"14":  "Smart Array 4200",
+		"15":  "Integrated Smart Array",
+		"16":  "Smart Array 431",
+		"17":  "Smart Array 5300",
+		"18":  "RAID LC2",
+		"19":  "Smart Array 5i",
+		"20":  "Smart Array 532",
+		"21":  "Smart Array 5312",
+		"22":  "Smart Array 641",
+		"23":  "Smart Array 642",
+		"24":  "Smart Array 6400",
+		"25":  "Smart Array 6400 EM",
+		"26":  "Smart Array 6i",
+		"27":  "Generic Smart Array",
+		"29":  "Smart Array P600",
+		"30":  "Smart Array P400",
+		"31":  "Smart Array E200",
+		"32":  "Smart Array E200i",
+		"33":  "Smart Array P400i",
+		"34":  "Smart Array P800",
+		"35":  "Smart Array E500",
+		"36":  "Smart Array P700m",
+		"37":  "Smart Array P212",
+		"38":  "Smart Array P410",
+		"39":  "Smart Array P410i",
+		"40":  "Smart Array P411",
+		"41":  "Smart Array B110i",
+		"42":  "Smart Array P712m",
+		"43":  "Smart Array P711m",
+		"44":  "Smart Array P812",
+		"45":  "StorageWorks 1210m",
+		"46":  "Smart Array P220i",
+		"47":  "Smart Array P222",
+		"48":  "Smart Array P420",
+		"49":  "Smart Array P420i",
+		"50":  "Smart Array P421",
+		"51":  "Smart Array B320i",
+		"52":  "Smart Array P822",
+		"53":  "Smart Array P721m",
+		"54":  "Smart Array B120i",
+		"55":  "HPS 1224",
+		"56":  "HPS 1228",
+		"57":  "HPS 1228m",
+		"58":  "Smart Array P822se",
+		"59":  "HPS 1224e",
+		"60":  "HPS 1228e",
+		"61":  "HPS 1228em",
+		"62":  "Smart Array P230i",
+		"63":  "Smart Array P430i",
+		"64":  "Smart Array P430",
+		"65":  "Smart Array P431",
+		"66":  "Smart Array P731m",
+		"67":  "Smart Array P830i",
+		"68":  "Smart Array P830",
+		"69":  "Smart Array P831",
+		"70":  "Smart Array P440ar",
+		"71":  "Smart Array P440",
+		"72":  "Smart Array P441",
+		"73":  "Smart Array P741m",
+		"74":  "Smart Array P840",
+		"75":  "Smart Array P841",
+		"76":  "Smart HBA H240ar",
+		"77":  "Smart HBA H244br",
+		"78":  "Smart HBA H240",
+		"79":  "Smart HBA H241",
+		"80":  "Smart Array B140i",
+		"81":  "Generic Smart HBA",
+		"82":  "Smart Array P240nr",
+		"83":  "Smart HBA H240nr",
+		"84":  "Smart Array P840ar",
+		"85":  "Smart Array P542D",
+		"86":  "Smart Array S100i",
+		"87":  "Smart Array E208i-p",
+		"88":  "Smart Array E208i-a",
+		"89":  "Smart Array E208i-c",
+		"90":  "Smart Array E208e-p",
+		"91":  "Smart Array P204i-b",
+		"92":  "Smart Array P204i-c",
+		"93":  "Smart Array P408i-p",
+		"94":  "Smart Array P408i-a",
+		"95":  "Smart Array P408e-p",
+		"96":  "Smart Array P408i-c",
+		"97":  "Smart Array P408e-m",
+		"98":  "Smart Array P416ie-m",
+		"99":  "Smart Array P816i-a",
+		"100": "Smart Array P408i-sb",
+	}
+	controllerRoleIDMappings = map[string]ControllerRole{
+		"1": ControllerRoleOther,
+		"2": ControllerRoleNotDuplexed,
+		"3": ControllerRoleActive,
+		"4": ControllerRoleBackup,
+	}
+	controllerRoleHumanMappings = map[ControllerRole]string{
+		ControllerRoleOther:       "Other",
+		ControllerRoleNotDuplexed: "Not Duplexed",
+		ControllerRoleActive:      "Active",
+		ControllerRoleBackup:      "Backup",
+	}
+	controllerBoardStatusIDMappings = map[string]ControllerBoardStatus{
+		"1": ControllerBoardStatusOther,
+		"2": ControllerBoardStatusOK,
+		"3": ControllerBoardStatusGeneralFailure,
+		"4": ControllerBoardStatusCableProblem,
+		"5": ControllerBoardStatusPoweredOff,
+		"6": ControllerBoardStatusCacheModuleMissing,
+	}
+	controllerBoardStatusHumanMappings = map[ControllerBoardStatus]string{
+		ControllerBoardStatusOther:              "Other",
+		ControllerBoardStatusOK:                 "OK",
+		ControllerBoardStatusGeneralFailure:     "General Failure",
+		ControllerBoardStatusCableProblem:       "Cable Problem",
+		ControllerBoardStatusPoweredOff:         "Powered Off",
+		ControllerBoardStatusCacheModuleMissing: "Cache Module Missing",
+	}
+	driveWriteCacheStateIDMappings = map[string]DriveWriteCacheState{
+		"1": DriveWriteCacheStateOther,
+		"2": DriveWriteCacheStateDisabled,
+		"3": DriveWriteCacheStateEnabled,
+	}
+	driveWriteCacheStateHumanMappings = map[DriveWriteCacheState]string{
+		DriveWriteCacheStateOther:    "Other",
+		DriveWriteCacheStateDisabled: "Disabled",
+		DriveWriteCacheStateEnabled:  "Enabled",
+	}
+	controllerEncryptionStatusIDMappings = map[string]ControllerEncryptionStatus{
+		"1": ControllerEncryptionStatusOther,
+		"2": ControllerEncryptionStatusEncrypted,
+		"3": ControllerEncryptionStatusNotEncrypted,
+	}
+	controllerEncryptionStatusHumanMappings = map[ControllerEncryptionStatus]string{
+		ControllerEncryptionStatusOther:        "Other",
+		ControllerEncryptionStatusEncrypted:    "Encrypted",
+		ControllerEncryptionStatusNotEncrypted: "Not Encrypted",
+	}
 )
 
 // Controllers returns a list of Controllers. Returns a non-nil error of the list of Controllers
@@ -39,6 +260,23 @@ func (m *MIB) Controllers() ([]Controller, error) {
 		return []Controller{}, err
 	}
 
+	// The cache module details are kept in the Array Accelerator table.
+	optionalColumns := OIDList{
+		cpqDaCntlrModel,
+		cpqDaCntlrFWRev,
+		cpqDaCntlrCurrentRole,
+		cpqDaCntlrBoardStatus,
+		cpqDaCntlrBoardCondition,
+		cpqDaCntlrDriveWriteCacheState,
+		cpqDaCntlrEncryptionStatus,
+		cpqDaAccelTotalMemory,
+		cpqDaAccelSerialNumber,
+	}
+	optional, err := traverseOptionalColumns(m.snmpClient, optionalColumns)
+	if err != nil {
+		return []Controller{}, err
+	}
+
 	for _, row := range table {
 		index, err := strconv.Atoi(row[0])
 		if err != nil {
@@ -51,15 +289,121 @@ func (m *MIB) Controllers() ([]Controller, error) {
 		status := parseStatus(row[2])
 		serialNo := prettifyString(row[3])
 		location := prettifyString(row[4])
+		model := ""
+		if id, ok := optional[cpqDaCntlrModel][row[0]]; ok {
+			model = parseControllerModel(id)
+		}
+		firmwareRev := prettifyString(optional[cpqDaCntlrFWRev][row[0]])
+		role := parseControllerRole(optional[cpqDaCntlrCurrentRole][row[0]])
+		boardStatus := parseControllerBoardStatus(optional[cpqDaCntlrBoardStatus][row[0]])
+		boardCondition := parseStatus(optional[cpqDaCntlrBoardCondition][row[0]])
+		driveWriteCache := parseDriveWriteCacheState(optional[cpqDaCntlrDriveWriteCacheState][row[0]])
+		encryptionStatus := parseControllerEncryptionStatus(optional[cpqDaCntlrEncryptionStatus][row[0]])
+		// The total memory of the cache module is reported in kilobytes.
+		cacheSize, err := parseOptionalInt(optional[cpqDaAccelTotalMemory], row[0])
+		if err != nil {
+			return []Controller{}, err
+		}
+		if cacheSize > 0 {
+			cacheSize /= 1024
+		}
+		cacheSerialNo := prettifyString(optional[cpqDaAccelSerialNumber][row[0]])
 
 		controllers = append(controllers, Controller{
-			ID:       index,
-			SlotNo:   slot,
-			Status:   status,
-			SerialNo: serialNo,
-			Location: location,
+			ID:               index,
+			SlotNo:           slot,
+			Status:           status,
+			SerialNo:         serialNo,
+			Location:         location,
+			Model:            model,
+			FirmwareRev:      firmwareRev,
+			BoardStatus:      boardStatus,
+			BoardCondition:   boardCondition,
+			Role:             role,
+			DriveWriteCache:  driveWriteCache,
+			EncryptionStatus: encryptionStatus,
+			CacheSizeMB:      cacheSize,
+			CacheSerialNo:    cacheSerialNo,
 		})
 	}
 
 	return controllers, nil
 }
+
+// parseControllerModel returns the model name that corresponds with the given string ID.
+// Returns "Unknown" if the given string ID cannot be accounted for.
+func parseControllerModel(s string) string {
+	model, ok := controllerModelMappings[s]
+	if !ok {
+		return "Unknown"
+	}
+	return model
+}
+
+func parseControllerRole(s string) ControllerRole {
+	role, ok := controllerRoleIDMappings[s]
+	if !ok {
+		return ControllerRoleUnknown
+	}
+	return role
+}
+
+// String converts the ControllerRole to a human readable string.
+func (c *ControllerRole) String() string {
+	s, ok := controllerRoleHumanMappings[*c]
+	if !ok {
+		return "Unknown"
+	}
+	return s
+}
+
+func parseControllerBoardStatus(s string) ControllerBoardStatus {
+	status, ok := controllerBoardStatusIDMappings[s]
+	if !ok {
+		return ControllerBoardStatusUnknown
+	}
+	return status
+}
+
+// String converts the ControllerBoardStatus to a human readable string.
+func (c *ControllerBoardStatus) String() string {
+	s, ok := controllerBoardStatusHumanMappings[*c]
+	if !ok {
+		return "Unknown"
+	}
+	return s
+}
+
+func parseDriveWriteCacheState(s string) DriveWriteCacheState {
+	state, ok := driveWriteCacheStateIDMappings[s]
+	if !ok {
+		return DriveWriteCacheStateUnknown
+	}
+	return state
+}
+
+// String converts the DriveWriteCacheState to a human readable string.
+func (d *DriveWriteCacheState) String() string {
+	s, ok := driveWriteCacheStateHumanMappings[*d]
+	if !ok {
+		return "Unknown"
+	}
+	return s
+}
+
+func parseControllerEncryptionStatus(s string) ControllerEncryptionStatus {
+	status, ok := controllerEncryptionStatusIDMappings[s]
+	if !ok {
+		return ControllerEncryptionStatusUnknown
+	}
+	return status
+}
+
+// String converts the ControllerEncryptionStatus to a human readable string.
+func (c *ControllerEncryptionStatus) String() string {
+	s, ok := controllerEncryptionStatusHumanMappings[*c]
+	if !ok {
+		return "Unknown"
+	}
+	return s
+}
@@ -0,0 +1,282 @@
+package hpmib
+
+import (
+	"strconv"
+)
+
+// DIMMType describes the form factor and memory generation of a DIMM.
+type DIMMType int
+
+// DIMMTechnology describes the technology of a DIMM.
+type DIMMTechnology int
+
+// DIMMECCStatus describes the state of the error correction of a DIMM.
+type DIMMECCStatus int
+
+// DIMM models a memory module in the system information tables of the HP MIB.
+type DIMM struct {
+	// BoardID is the index of the memory board this DIMM is installed on.
+	BoardID int
+	// ID is the index of the slot this DIMM is installed in on its memory board.
+	ID int
+	// SizeKB is the size of this DIMM in kilobytes. Set to 0 if the slot is empty.
+	SizeKB int
+	// Type is the form factor and memory generation of this DIMM, e.g. DDR3.
+	Type DIMMType
+	// Technology is the technology of this DIMM, e.g. registered.
+	Technology DIMMTechnology
+	// ECCStatus is the state of the error correction of this DIMM. Set to DIMMECCStatusUnknown if not
+	// reported by the agent.
+	ECCStatus DIMMECCStatus
+	// Manufacturer is the manufacturer of this DIMM. Empty if not reported by the agent.
+	Manufacturer string
+	// PartNo is the part number of this DIMM. Empty if not reported by the agent.
+	PartNo string
+	// SerialNo is the serial number of this DIMM. Empty if not reported by the agent.
+	SerialNo string
+	// SpeedMHz is the operating frequency of this DIMM. Set to 0 if the slot is empty.
+	SpeedMHz int
+	// HPSmartMemory is true if the agent reports this DIMM as HP Smart Memory. Set to false if not
+	// reported by the agent.
+	HPSmartMemory bool
+}
+
+// Table defined by the HP MIB that contains the properties of each memory module. The agents this
+// package is tested against leave the manufacturer, part number, serial number and HP Smart Memory
+// columns empty or set to 0, and report 0 for the ECC status, which is not one of its defined values.
+const (
+	cpqSiMemBoardIndex         OID = "1.3.6.1.4.1.232.2.2.4.5.1.1"
+	cpqSiMemModuleIndex        OID = "1.3.6.1.4.1.232.2.2.4.5.1.2"
+	cpqSiMemModuleSize         OID = "1.3.6.1.4.1.232.2.2.4.5.1.3"
+	cpqSiMemModuleType         OID = "1.3.6.1.4.1.232.2.2.4.5.1.4"
+	cpqSiMemECCStatus          OID = "1.3.6.1.4.1.232.2.2.4.5.1.5"
+	cpqSiMemModuleTechnology   OID = "1.3.6.1.4.1.232.2.2.4.5.1.6"
+	cpqSiMemModuleManufacturer OID = "1.3.6.1.4.1.232.2.2.4.5.1.7"
+	cpqSiMemModulePartNo       OID = "1.3.6.1.4.1.232.2.2.4.5.1.8"
+	cpqSiMemModuleSerialNo     OID = "1.3.6.1.4.1.232.2.2.4.5.1.10"
+	cpqSiMemModuleHPSmartMem   OID = "1.3.6.1.4.1.232.2.2.4.5.1.11"
+	cpqSiMemModuleFrequency    OID = "1.3.6.1.4.1.232.2.2.4.5.1.13"
+)
+
+// DIMM types defined by the HP MIB.
+const (
+	DIMMTypeUnknown  DIMMType = -1
+	DIMMTypeOther    DIMMType = 1
+	DIMMTypeSpecific DIMMType = 7
+	DIMMTypeDIMM     DIMMType = 8
+	DIMMTypeSODIMM   DIMMType = 9
+	DIMMTypeFBDIMM   DIMMType = 12
+	DIMMTypeDDR      DIMMType = 13
+	DIMMTypeDDR2     DIMMType = 14
+	DIMMTypeDDR3     DIMMType = 15
+	DIMMTypeFBDDDR2  DIMMType = 17
+	DIMMTypeFBDDDR3  DIMMType = 18
+	DIMMTypeDDR4     DIMMType = 19
+)
+
+// DIMM technologies defined by the HP MIB.
+const (
+	DIMMTechnologyUnknown      DIMMTechnology = -1
+	DIMMTechnologyOther        DIMMTechnology = 1
+	DIMMTechnologyFastPageMode DIMMTechnology = 2
+	DIMMTechnologyEDO          DIMMTechnology = 3
+	DIMMTechnologyBurstEDO     DIMMTechnology = 4
+	DIMMTechnologySynchronous  DIMMTechnology = 5
+	DIMMTechnologyRDRAM        DIMMTechnology = 6
+	DIMMTechnologyRegistered   DIMMTechnology = 7
+	DIMMTechnologyUnbuffered   DIMMTechnology = 8
+	DIMMTechnologyLoadReduced  DIMMTechnology = 9
+)
+
+// DIMM ECC states defined by the HP MIB.
+const (
+	DIMMECCStatusUnknown                    DIMMECCStatus = -1
+	DIMMECCStatusOther                      DIMMECCStatus = 1
+	DIMMECCStatusOK                         DIMMECCStatus = 2
+	DIMMECCStatusDegraded                   DIMMECCStatus = 3
+	DIMMECCStatusDegradedModuleIndexUnknown DIMMECCStatus = 4
+)
+
+// HP Smart Memory value defined by the HP MIB for a DIMM that is HP Smart Memory.
+const dimmHPSmartMemory = "3"
+
+var (
+	dimmTypeIDMappings = map[string]DIMMType{
+		"1":  DIMMTypeOther,
+		"7":  DIMMTypeSpecific,
+		"8":  DIMMTypeDIMM,
+		"9":  DIMMTypeSODIMM,
+		"12": DIMMTypeFBDIMM,
+		"13": DIMMTypeDDR,
+		"14": DIMMTypeDDR2,
+		"15": DIMMTypeDDR3,
+		"17": DIMMTypeFBDDDR2,
+		"18": DIMMTypeFBDDDR3,
+		"19": DIMMTypeDDR4,
+	}
+	dimmTypeHumanMappings = map[DIMMType]string{
+		DIMMTypeOther:    "Other",
+		DIMMTypeSpecific: "HP Specific",
+		DIMMTypeDIMM:     "DIMM",
+		DIMMTypeSODIMM:   "SODIMM",
+		DIMMTypeFBDIMM:   "FB-DIMM",
+		DIMMTypeDDR:      "DDR",
+		DIMMTypeDDR2:     "DDR2",
+		DIMMTypeDDR3:     "DDR3",
+		DIMMTypeFBDDDR2:  "FB-DIMM DDR2",
+		DIMMTypeFBDDDR3:  "FB-DIMM DDR3",
+		DIMMTypeDDR4:     "DDR4",
+	}
+	dimmTechnologyIDMappings = map[string]DIMMTechnology{
+		"1": DIMMTechnologyOther,
+		"2": DIMMTechnologyFastPageMode,
+		"3": DIMMTechnologyEDO,
+		"4": DIMMTechnologyBurstEDO,
+		"5": DIMMTechnologySynchronous,
+		"6": DIMMTechnologyRDRAM,
+		"7": DIMMTechnologyRegistered,
+		"8": DIMMTechnologyUnbuffered,
+		"9": DIMMTechnologyLoadReduced,
+	}
+	dimmTechnologyHumanMappings = map[DIMMTechnology]string{
+		DIMMTechnologyOther:        "Other",
+		DIMMTechnologyFastPageMode: "Fast Page Mode",
+		DIMMTechnologyEDO:          "EDO",
+		DIMMTechnologyBurstEDO:     "Burst EDO",
+		DIMMTechnologySynchronous:  "Synchronous",
+		DIMMTechnologyRDRAM:        "RDRAM",
+		DIMMTechnologyRegistered:   "Registered",
+		DIMMTechnologyUnbuffered:   "Unbuffered",
+		DIMMTechnologyLoadReduced:  "Load Reduced",
+	}
+	dimmECCStatusIDMappings = map[string]DIMMECCStatus{
+		"1": DIMMECCStatusOther,
+		"2": DIMMECCStatusOK,
+		"3": DIMMECCStatusDegraded,
+		"4": DIMMECCStatusDegradedModuleIndexUnknown,
+	}
+	dimmECCStatusHumanMappings = map[DIMMECCStatus]string{
+		DIMMECCStatusOther:                      "Other",
+		DIMMECCStatusOK:                         "OK",
+		DIMMECCStatusDegraded:                   "Degraded",
+		DIMMECCStatusDegradedModuleIndexUnknown: "Degraded, Module Unknown",
+	}
+)
+
+// DIMMs returns a list of DIMMs. Returns a non-nil error if the list of DIMMs could not be determined.
+func (m *MIB) DIMMs() ([]DIMM, error) {
+	dimms := []DIMM{}
+
+	columns := OIDList{
+		cpqSiMemBoardIndex,
+		cpqSiMemModuleIndex,
+		cpqSiMemModuleSize,
+		cpqSiMemModuleType,
+		cpqSiMemECCStatus,
+		cpqSiMemModuleTechnology,
+		cpqSiMemModuleManufacturer,
+		cpqSiMemModulePartNo,
+		cpqSiMemModuleSerialNo,
+		cpqSiMemModuleHPSmartMem,
+		cpqSiMemModuleFrequency,
+	}
+	table, err := traverseTable(m.snmpClient, columns)
+	if err != nil {
+		return []DIMM{}, err
+	}
+
+	for _, row := range table {
+		dimm, err := parseDIMM(row)
+		if err != nil {
+			return []DIMM{}, err
+		}
+		dimms = append(dimms, dimm)
+	}
+
+	return dimms, nil
+}
+
+// parseDIMM parses a row of the memory module table, whose columns are ordered as in DIMMs.
+func parseDIMM(row []string) (DIMM, error) {
+	board, err := strconv.Atoi(row[0])
+	if err != nil {
+		return DIMM{}, err
+	}
+	index, err := strconv.Atoi(row[1])
+	if err != nil {
+		return DIMM{}, err
+	}
+	size, err := strconv.Atoi(row[2])
+	if err != nil {
+		return DIMM{}, err
+	}
+	frequency, err := strconv.Atoi(row[10])
+	if err != nil {
+		return DIMM{}, err
+	}
+
+	return DIMM{
+		BoardID:       board,
+		ID:            index,
+		SizeKB:        size,
+		Type:          parseDIMMType(row[3]),
+		ECCStatus:     parseDIMMECCStatus(row[4]),
+		Technology:    parseDIMMTechnology(row[5]),
+		Manufacturer:  prettifyString(row[6]),
+		PartNo:        prettifyString(row[7]),
+		SerialNo:      prettifyString(row[8]),
+		SpeedMHz:      frequency,
+		HPSmartMemory: row[9] == dimmHPSmartMemory,
+	}, nil
+}
+
+func parseDIMMType(s string) DIMMType {
+	dimmType, ok := dimmTypeIDMappings[s]
+	if !ok {
+		return DIMMTypeUnknown
+	}
+	return dimmType
+}
+
+// String converts the DIMMType to a human readable string.
+func (d *DIMMType) String() string {
+	s, ok := dimmTypeHumanMappings[*d]
+	if !ok {
+		return "Unknown"
+	}
+	return s
+}
+
+func parseDIMMTechnology(s string) DIMMTechnology {
+	technology, ok := dimmTechnologyIDMappings[s]
+	if !ok {
+		return DIMMTechnologyUnknown
+	}
+	return technology
+}
+
+// String converts the DIMMTechnology to a human readable string.
+func (d *DIMMTechnology) String() string {
+	s, ok := dimmTechnologyHumanMappings[*d]
+	if !ok {
+		return "Unknown"
+	}
+	return s
+}
+
+func parseDIMMECCStatus(s string) DIMMECCStatus {
+	status, ok := dimmECCStatusIDMappings[s]
+	if !ok {
+		return DIMMECCStatusUnknown
+	}
+	return status
+}
+
+// String converts the DIMMECCStatus to a human readable string.
+func (d *DIMMECCStatus) String() string {
+	s, ok := dimmECCStatusHumanMappings[*d]
+	if !ok {
+		return "Unknown"
+	}
+	return s
+}
@@ -0,0 +1,149 @@
+package hpmib
+
+import (
+	"strconv"
+	"time"
+)
+
+// DiskIOStats models the I/O counters of a block device reported by the host OS agents in the HP MIB.
+// Each counter is a Counter32 that wraps around at 2^32.
+type DiskIOStats struct {
+	// Major is the major device number of this block device.
+	Major int
+	// Minor is the minor device number of this block device.
+	Minor int
+	// Name is the kernel name of this block device, e.g. "sda".
+	Name string
+	// DevicePath is the path of this block device, e.g. "/dev/sda", which matches LogicalDrive.Name.
+	DevicePath string
+	// Reads is the number of reads completed.
+	Reads uint32
+	// ReadMerges is the number of adjacent reads merged into a single read.
+	ReadMerges uint32
+	// ReadSectors is the number of sectors read.
+	ReadSectors uint32
+	// ReadTimeMs is the number of milliseconds spent servicing reads.
+	ReadTimeMs uint32
+	// Writes is the number of writes completed.
+	Writes uint32
+	// WriteSectors is the number of sectors written.
+	WriteSectors uint32
+	// WriteTimeMs is the number of milliseconds spent servicing writes.
+	WriteTimeMs uint32
+}
+
+// DiskIORates contains the per-second rates and average service times of a block device,
+// computed from two samples of DiskIOStats.
+type DiskIORates struct {
+	Reads        float64
+	ReadMerges   float64
+	ReadSectors  float64
+	Writes       float64
+	WriteSectors float64
+	// ReadServiceTimeMs is the average number of milliseconds spent servicing each read.
+	ReadServiceTimeMs float64
+	// WriteServiceTimeMs is the average number of milliseconds spent servicing each write.
+	WriteServiceTimeMs float64
+}
+
+// Table defined by the HP MIB that contains the I/O counters of each block device.
+const (
+	cpqLinOsDiskMajorIndex      OID = "1.3.6.1.4.1.232.23.2.7.2.1.1"
+	cpqLinOsDiskMinorIndex      OID = "1.3.6.1.4.1.232.23.2.7.2.1.2"
+	cpqLinOsDiskName            OID = "1.3.6.1.4.1.232.23.2.7.2.1.3"
+	cpqLinOsDiskReadIos         OID = "1.3.6.1.4.1.232.23.2.7.2.1.5"
+	cpqLinOsDiskReadMerges      OID = "1.3.6.1.4.1.232.23.2.7.2.1.6"
+	cpqLinOsDiskReadSectors     OID = "1.3.6.1.4.1.232.23.2.7.2.1.7"
+	cpqLinOsDiskReadDurationMs  OID = "1.3.6.1.4.1.232.23.2.7.2.1.8"
+	cpqLinOsDiskWriteIos        OID = "1.3.6.1.4.1.232.23.2.7.2.1.9"
+	cpqLinOsDiskWriteSectors    OID = "1.3.6.1.4.1.232.23.2.7.2.1.10"
+	cpqLinOsDiskWriteDurationMs OID = "1.3.6.1.4.1.232.23.2.7.2.1.11"
+)
+
+// DiskIOStats returns the I/O counters of each block device. Returns a non-nil error if the
+// I/O counters could not be determined.
+func (m *MIB) DiskIOStats() ([]DiskIOStats, error) {
+	stats := []DiskIOStats{}
+
+	columns := OIDList{
+		cpqLinOsDiskMajorIndex,
+		cpqLinOsDiskMinorIndex,
+		cpqLinOsDiskName,
+		cpqLinOsDiskReadIos,
+		cpqLinOsDiskReadMerges,
+		cpqLinOsDiskReadSectors,
+		cpqLinOsDiskReadDurationMs,
+		cpqLinOsDiskWriteIos,
+		cpqLinOsDiskWriteSectors,
+		cpqLinOsDiskWriteDurationMs,
+	}
+	table, err := traverseTable(m.snmpClient, columns)
+	if err != nil {
+		return []DiskIOStats{}, err
+	}
+
+	for _, row := range table {
+		major, err := strconv.Atoi(row[0])
+		if err != nil {
+			return []DiskIOStats{}, err
+		}
+		minor, err := strconv.Atoi(row[1])
+		if err != nil {
+			return []DiskIOStats{}, err
+		}
+		name := prettifyString(row[2])
+		counters := make([]uint32, 0, len(row[3:]))
+		for _, col := range row[3:] {
+			counter, err := strconv.ParseUint(col, 10, 32)
+			if err != nil {
+				return []DiskIOStats{}, err
+			}
+			counters = append(counters, uint32(counter))
+		}
+
+		stats = append(stats, DiskIOStats{
+			Major:        major,
+			Minor:        minor,
+			Name:         name,
+			DevicePath:   "/dev/" + name,
+			Reads:        counters[0],
+			ReadMerges:   counters[1],
+			ReadSectors:  counters[2],
+			ReadTimeMs:   counters[3],
+			Writes:       counters[4],
+			WriteSectors: counters[5],
+			WriteTimeMs:  counters[6],
+		})
+	}
+
+	return stats, nil
+}
+
+// Rates computes the per-second rate of each counter and the average service times between a previous
+// sample and this sample, which were taken elapsed apart. Counters that wrapped around between the
+// samples are accounted for. Returns ErrInvalidSampleInterval if elapsed is not greater than zero.
+func (d *DiskIOStats) Rates(previous *DiskIOStats, elapsed time.Duration) (DiskIORates, error) {
+	if elapsed <= 0 {
+		return DiskIORates{}, ErrInvalidSampleInterval
+	}
+	seconds := elapsed.Seconds()
+	rate := func(previous, current uint32) float64 {
+		return float64(counter32Delta(previous, current)) / seconds
+	}
+	serviceTime := func(previousIos, currentIos, previousMs, currentMs uint32) float64 {
+		ios := counter32Delta(previousIos, currentIos)
+		if ios == 0 {
+			return 0
+		}
+		return float64(counter32Delta(previousMs, currentMs)) / float64(ios)
+	}
+	return DiskIORates{
+		Reads:              rate(previous.Reads, d.Reads),
+		ReadMerges:         rate(previous.ReadMerges, d.ReadMerges),
+		ReadSectors:        rate(previous.ReadSectors, d.ReadSectors),
+		Writes:             rate(previous.Writes, d.Writes),
+		WriteSectors:       rate(previous.WriteSectors, d.WriteSectors),
+		ReadServiceTimeMs:  serviceTime(previous.Reads, d.Reads, previous.ReadTimeMs, d.ReadTimeMs),
+		WriteServiceTimeMs: serviceTime(previous.Writes, d.Writes, previous.WriteTimeMs, d.WriteTimeMs),
+	}, nil
+}
@@ -13,4 +13,7 @@ var (
 	// ErrExpectedOctetString is the error returned when the value at a specific OID was expected to be an octet string but
 	// another type was returned instead.
 	ErrExpectedOctetString = errors.New("expected value to be a octet string")
+	// ErrInvalidSampleInterval is the error returned when computing rates from two samples that were not taken
+	// a positive amount of time apart.
+	ErrInvalidSampleInterval = errors.New("expected sample interval to be greater than zero")
 )
@@ -11,20 +11,69 @@ type FanLocale int
 // FanRedundancy describes the fault tolerance of the fan.
 type FanRedundancy int
 
-// Fan models a fan in the HP MIB.
+// FanSpeed describes the speed a fan is running at.
+type FanSpeed int
+
+// FanPresence describes whether a fan is installed.
+type FanPresence int
+
+// FanHotPlug describes whether a fan can be removed while the system is running.
+type FanHotPlug int
+
+// Fan models a fan in the HP MIB. SpeedPercent is set to -1 and Location is empty if they are not
+// reported by the agent, which is the case on generations prior to Gen9. Likewise RedundancyPartnerID
+// is set to -1, and Speed, Present and HotPluggable to their Unknown value if not reported.
 type Fan struct {
-	ID         int
-	Locale     FanLocale
-	Redundancy FanRedundancy
-	Status     Status
+	ID                  int
+	Locale              FanLocale
+	Redundancy          FanRedundancy
+	Status              Status
+	ChassisID           int
+	Present             FanPresence
+	HotPluggable        FanHotPlug
+	Speed               FanSpeed
+	SpeedPercent        int
+	RedundancyPartnerID int
+	Location            string
 }
 
 // Table defined by the HP MIB that contains the status of each fan.
 const (
-	cpqHeFltTolFanIndex     OID = "1.3.6.1.4.1.232.6.2.6.7.1.2"
-	cpqHeFltTolFanLocale    OID = "1.3.6.1.4.1.232.6.2.6.7.1.3"
-	cpqHeFltTolFanRedundant OID = "1.3.6.1.4.1.232.6.2.6.7.1.7"
-	cpqHeFltTolFanCondition OID = "1.3.6.1.4.1.232.6.2.6.7.1.9"
+	cpqHeFltTolFanChassis          OID = "1.3.6.1.4.1.232.6.2.6.7.1.1"
+	cpqHeFltTolFanIndex            OID = "1.3.6.1.4.1.232.6.2.6.7.1.2"
+	cpqHeFltTolFanLocale           OID = "1.3.6.1.4.1.232.6.2.6.7.1.3"
+	cpqHeFltTolFanPresent          OID = "1.3.6.1.4.1.232.6.2.6.7.1.4"
+	cpqHeFltTolFanSpeed            OID = "1.3.6.1.4.1.232.6.2.6.7.1.6"
+	cpqHeFltTolFanRedundant        OID = "1.3.6.1.4.1.232.6.2.6.7.1.7"
+	cpqHeFltTolFanRedundantPartner OID = "1.3.6.1.4.1.232.6.2.6.7.1.8"
+	cpqHeFltTolFanCondition        OID = "1.3.6.1.4.1.232.6.2.6.7.1.9"
+	cpqHeFltTolFanHotPlug          OID = "1.3.6.1.4.1.232.6.2.6.7.1.10"
+	cpqHeFltTolFanHwLocation       OID = "1.3.6.1.4.1.232.6.2.6.7.1.11"
+	cpqHeFltTolFanCurrentSpeed     OID = "1.3.6.1.4.1.232.6.2.6.7.1.12"
+)
+
+// Speeds for a fan defined by the HP MIB.
+const (
+	FanSpeedUnknown FanSpeed = -1
+	FanSpeedOther   FanSpeed = 1
+	FanSpeedNormal  FanSpeed = 2
+	FanSpeedHigh    FanSpeed = 3
+)
+
+// Presence states for a fan defined by the HP MIB.
+const (
+	FanPresenceUnknown FanPresence = -1
+	FanPresenceOther   FanPresence = 1
+	FanPresenceAbsent  FanPresence = 2
+	FanPresencePresent FanPresence = 3
+)
+
+// Hot plug capabilities for a fan defined by the HP MIB.
+const (
+	FanHotPlugUnknown         FanHotPlug = -1
+	FanHotPlugOther           FanHotPlug = 1
+	FanHotPlugNonHotPluggable FanHotPlug = 2
+	FanHotPlugHotPluggable    FanHotPlug = 3
 )
 
 // Redundancy states for a fan defined by the HP MIB.
@@ -108,6 +157,36 @@ var (
 		FanRedundancyNotRedundant: "Not Redundant",
 		FanRedundancyRedundant:    "Redundant",
 	}
+	fanSpeedIDMappings = map[string]FanSpeed{
+		"1": FanSpeedOther,
+		"2": FanSpeedNormal,
+		"3": FanSpeedHigh,
+	}
+	fanSpeedHumanMappings = map[FanSpeed]string{
+		FanSpeedOther:  "Other",
+		FanSpeedNormal: "Normal",
+		FanSpeedHigh:   "High",
+	}
+	fanPresenceIDMappings = map[string]FanPresence{
+		"1": FanPresenceOther,
+		"2": FanPresenceAbsent,
+		"3": FanPresencePresent,
+	}
+	fanPresenceHumanMappings = map[FanPresence]string{
+		FanPresenceOther:   "Other",
+		FanPresenceAbsent:  "Absent",
+		FanPresencePresent: "Present",
+	}
+	fanHotPlugIDMappings = map[string]FanHotPlug{
+		"1": FanHotPlugOther,
+		"2": FanHotPlugNonHotPluggable,
+		"3": FanHotPlugHotPluggable,
+	}
+	fanHotPlugHumanMappings = map[FanHotPlug]string{
+		FanHotPlugOther:           "Other",
+		FanHotPlugNonHotPluggable: "Non Hot Pluggable",
+		FanHotPlugHotPluggable:    "Hot Pluggable",
+	}
 )
 
 // Fans returns a list of Fans. Returns a non-nil error of the list of Fans
@@ -120,12 +199,28 @@ func (m *MIB) Fans() ([]Fan, error) {
 		cpqHeFltTolFanLocale,
 		cpqHeFltTolFanRedundant,
 		cpqHeFltTolFanCondition,
+		cpqHeFltTolFanChassis,
 	}
 	table, err := traverseTable(m.snmpClient, columns)
 	if err != nil {
 		return []Fan{}, err
 	}
 
+	// The chassis is part of the index of the table, so it is reported for every fan and is walked
+	// with the table to key the other columns.
+	optionalColumns := OIDList{
+		cpqHeFltTolFanPresent,
+		cpqHeFltTolFanSpeed,
+		cpqHeFltTolFanRedundantPartner,
+		cpqHeFltTolFanHotPlug,
+		cpqHeFltTolFanHwLocation,
+		cpqHeFltTolFanCurrentSpeed,
+	}
+	optional, err := traverseOptionalColumns(m.snmpClient, optionalColumns)
+	if err != nil {
+		return []Fan{}, err
+	}
+
 	for _, row := range table {
 		index, err := strconv.Atoi(row[0])
 		if err != nil {
@@ -134,12 +229,36 @@ func (m *MIB) Fans() ([]Fan, error) {
 		locale := parseFanLocale(row[1])
 		redundancy := parseFanRedundancy(row[2])
 		status := parseStatus(row[3])
+		chassis, err := strconv.Atoi(row[4])
+		if err != nil {
+			return []Fan{}, err
+		}
+		key := row[4] + "." + row[0]
+		present := parseFanPresence(optional[cpqHeFltTolFanPresent][key])
+		speed := parseFanSpeed(optional[cpqHeFltTolFanSpeed][key])
+		partner, err := parseOptionalInt(optional[cpqHeFltTolFanRedundantPartner], key)
+		if err != nil {
+			return []Fan{}, err
+		}
+		hotPluggable := parseFanHotPlug(optional[cpqHeFltTolFanHotPlug][key])
+		location := prettifyString(optional[cpqHeFltTolFanHwLocation][key])
+		speedPercent, err := parseOptionalInt(optional[cpqHeFltTolFanCurrentSpeed], key)
+		if err != nil {
+			return []Fan{}, err
+		}
 
 		fans = append(fans, Fan{
-			ID:         index,
-			Status:     status,
-			Locale:     locale,
-			Redundancy: redundancy,
+			ID:                  index,
+			Status:              status,
+			Locale:              locale,
+			Redundancy:          redundancy,
+			ChassisID:           chassis,
+			Present:             present,
+			HotPluggable:        hotPluggable,
+			Speed:               speed,
+			SpeedPercent:        speedPercent,
+			RedundancyPartnerID: partner,
+			Location:            location,
 		})
 	}
 
@@ -179,3 +298,54 @@ func (f *FanRedundancy) String() string {
 	}
 	return s
 }
+
+func parseFanSpeed(s string) FanSpeed {
+	speed, ok := fanSpeedIDMappings[s]
+	if !ok {
+		return FanSpeedUnknown
+	}
+	return speed
+}
+
+// String converts the FanSpeed to a human readable string.
+func (f *FanSpeed) String() string {
+	s, ok := fanSpeedHumanMappings[*f]
+	if !ok {
+		return "Unknown"
+	}
+	return s
+}
+
+func parseFanPresence(s string) FanPresence {
+	presence, ok := fanPresenceIDMappings[s]
+	if !ok {
+		return FanPresenceUnknown
+	}
+	return presence
+}
+
+// String converts the FanPresence to a human readable string.
+func (f *FanPresence) String() string {
+	s, ok := fanPresenceHumanMappings[*f]
+	if !ok {
+		return "Unknown"
+	}
+	return s
+}
+
+func parseFanHotPlug(s string) FanHotPlug {
+	hotPlug, ok := fanHotPlugIDMappings[s]
+	if !ok {
+		return FanHotPlugUnknown
+	}
+	return hotPlug
+}
+
+// String converts the FanHotPlug to a human readable string.
+func (f *FanHotPlug) String() string {
+	s, ok := fanHotPlugHumanMappings[*f]
+	if !ok {
+		return "Unknown"
+	}
+	return s
+}
@@ -0,0 +1,189 @@
+package hpmib
+
+import (
+	"strconv"
+	"strings"
+)
+
+// FileSystem models a mounted filesystem reported by the host OS agents in the HP MIB.
+type FileSystem struct {
+	// ID is the index of this filesystem.
+	ID int
+	// Description describes the mount of this filesystem, e.g. "/dev/sda1 on /boot".
+	Description string
+	// Device is the device or pseudo-filesystem that is mounted, e.g. "/dev/sda1" or "cgroup".
+	Device string
+	// MountPoint is the path this filesystem is mounted on, e.g. "/boot".
+	MountPoint string
+	// TotalMB is the total size of this filesystem in megabytes.
+	TotalMB int
+	// UsedMB is the amount of space used on this filesystem in megabytes.
+	UsedMB int
+	// FreeMB is the amount of space available on this filesystem in megabytes.
+	FreeMB int
+	// PercentUsed is the percentage of space used on this filesystem.
+	PercentUsed int
+	// Condition is the condition of this filesystem with respect to its usage thresholds.
+	// Set to StatusUnknown if the agent does not report a condition for each filesystem.
+	Condition Status
+}
+
+// Table defined by the HP MIB that contains the usage of each mounted filesystem.
+const (
+	cpqHoFileSysIndex            OID = "1.3.6.1.4.1.232.11.2.4.1.1.1"
+	cpqHoFileSysDesc             OID = "1.3.6.1.4.1.232.11.2.4.1.1.2"
+	cpqHoFileSysSpaceTotal       OID = "1.3.6.1.4.1.232.11.2.4.1.1.3"
+	cpqHoFileSysSpaceUsed        OID = "1.3.6.1.4.1.232.11.2.4.1.1.4"
+	cpqHoFileSysPercentSpaceUsed OID = "1.3.6.1.4.1.232.11.2.4.1.1.5"
+	cpqHoFileSysStatus           OID = "1.3.6.1.4.1.232.11.2.4.1.1.8"
+)
+
+// pseudoFileSystems contains the names of filesystems that are not backed by storage.
+var pseudoFileSystems = map[string]bool{
+	"binfmt_misc": true,
+	"bpf":         true,
+	"cgroup":      true,
+	"cgroup2":     true,
+	"configfs":    true,
+	"debugfs":     true,
+	"devpts":      true,
+	"fusectl":     true,
+	"hugetlbfs":   true,
+	"mqueue":      true,
+	"proc":        true,
+	"pstore":      true,
+	"securityfs":  true,
+	"selinuxfs":   true,
+	"sunrpc":      true,
+	"sysfs":       true,
+	"tracefs":     true,
+}
+
+// pseudoMountPoints contains the mount points of kernel interfaces that are not backed by storage.
+// Filesystems mounted on or below them are pseudo-filesystems whatever their device is named, e.g.
+// "none on /proc/sys/fs/binfmt_misc".
+var pseudoMountPoints = []string{
+	"/proc",
+	"/sys",
+	"/dev/pts",
+}
+
+// FileSystems returns the usage of each mounted filesystem. Returns a non-nil error if the
+// usage could not be determined.
+func (m *MIB) FileSystems() ([]FileSystem, error) {
+	fileSystems := []FileSystem{}
+
+	conditions, err := m.fileSystemConditions()
+	if err != nil {
+		return []FileSystem{}, err
+	}
+
+	columns := OIDList{
+		cpqHoFileSysIndex,
+		cpqHoFileSysDesc,
+		cpqHoFileSysSpaceTotal,
+		cpqHoFileSysSpaceUsed,
+		cpqHoFileSysPercentSpaceUsed,
+	}
+	table, err := traverseTable(m.snmpClient, columns)
+	if err != nil {
+		return []FileSystem{}, err
+	}
+
+	for _, row := range table {
+		index, err := strconv.Atoi(row[0])
+		if err != nil {
+			return []FileSystem{}, err
+		}
+		desc := prettifyString(row[1])
+		total, err := strconv.Atoi(row[2])
+		if err != nil {
+			return []FileSystem{}, err
+		}
+		used, err := strconv.Atoi(row[3])
+		if err != nil {
+			return []FileSystem{}, err
+		}
+		percentUsed, err := strconv.Atoi(row[4])
+		if err != nil {
+			return []FileSystem{}, err
+		}
+		condition, ok := conditions[index]
+		if !ok {
+			condition = StatusUnknown
+		}
+		device, mountPoint := parseFileSystemDesc(desc)
+
+		fileSystems = append(fileSystems, FileSystem{
+			ID:          index,
+			Description: desc,
+			Device:      device,
+			MountPoint:  mountPoint,
+			TotalMB:     total,
+			UsedMB:      used,
+			FreeMB:      total - used,
+			PercentUsed: percentUsed,
+			Condition:   condition,
+		})
+	}
+
+	return fileSystems, nil
+}
+
+// fileSystemConditions returns the condition of each mounted filesystem keyed by the index of the
+// filesystem. Older agents do not report this column, in which case the map is empty.
+func (m *MIB) fileSystemConditions() (map[int]Status, error) {
+	conditions := map[int]Status{}
+
+	statuses, err := traverseColumn(m.snmpClient, cpqHoFileSysStatus)
+	if err != nil {
+		return conditions, err
+	}
+
+	for key, value := range statuses {
+		index, err := strconv.Atoi(key)
+		if err != nil {
+			return conditions, err
+		}
+		conditions[index] = parseStatus(value)
+	}
+
+	return conditions, nil
+}
+
+// parseFileSystemDesc splits the description of a filesystem, e.g. "/dev/sda1 on /boot",
+// into the device and the mount point.
+func parseFileSystemDesc(s string) (string, string) {
+	parts := strings.SplitN(s, " on ", 2)
+	if len(parts) != 2 {
+		return s, ""
+	}
+	return parts[0], parts[1]
+}
+
+// IsPseudo returns true if the filesystem is a pseudo-filesystem that is not backed by storage,
+// such as cgroup, securityfs or hugetlbfs, or if it is mounted below a kernel interface such as
+// /proc or /sys.
+func (f *FileSystem) IsPseudo() bool {
+	if pseudoFileSystems[f.Device] {
+		return true
+	}
+	for _, mountPoint := range pseudoMountPoints {
+		if f.MountPoint == mountPoint || strings.HasPrefix(f.MountPoint, mountPoint+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// ExcludePseudoFileSystems returns the filesystems that are backed by storage, dropping
+// pseudo-filesystems such as cgroup, securityfs or hugetlbfs.
+func ExcludePseudoFileSystems(fileSystems []FileSystem) []FileSystem {
+	filtered := []FileSystem{}
+	for _, f := range fileSystems {
+		if !f.IsPseudo() {
+			filtered = append(filtered, f)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,196 @@
+package hpmib
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FirmwareCategory describes the kind of component a firmware runs on.
+type FirmwareCategory int
+
+// Firmware models a firmware component in the firmware inventory of the HP MIB.
+type Firmware struct {
+	// ID is the position of this firmware component in the firmware inventory.
+	ID int
+	// Name is the display name of this firmware component, e.g. "HP ProLiant System ROM".
+	Name string
+	// Version is the version of this firmware component, e.g. "1.26" or "P70 03.01.2013".
+	Version string
+	// Date is the release date embedded in the version of this firmware component, which is
+	// reported for system ROMs. Set to the zero time if the version does not contain a date.
+	Date time.Time
+	// Location is the location of this firmware component, e.g. "System Board" or "scsi0(00:00:00)".
+	Location string
+	// Category is the kind of component this firmware runs on.
+	Category FirmwareCategory
+}
+
+// Table defined by the HP MIB that contains the version of each firmware component.
+const (
+	cpqHoFwVerCategory    OID = "1.3.6.1.4.1.232.11.2.14.1.1.2"
+	cpqHoFwVerDisplayName OID = "1.3.6.1.4.1.232.11.2.14.1.1.4"
+	cpqHoFwVerVersion     OID = "1.3.6.1.4.1.232.11.2.14.1.1.5"
+	cpqHoFwVerLocation    OID = "1.3.6.1.4.1.232.11.2.14.1.1.6"
+)
+
+// Firmware categories defined by the HP MIB.
+const (
+	FirmwareCategoryUnknown FirmwareCategory = -1
+	FirmwareCategoryOther   FirmwareCategory = 1
+	FirmwareCategoryStorage FirmwareCategory = 2
+	FirmwareCategoryNIC     FirmwareCategory = 3
+	FirmwareCategoryRIB     FirmwareCategory = 4
+	FirmwareCategorySystem  FirmwareCategory = 5
+)
+
+var (
+	firmwareCategoryIDMappings = map[string]FirmwareCategory{
+		"1": FirmwareCategoryOther,
+		"2": FirmwareCategoryStorage,
+		"3": FirmwareCategoryNIC,
+		"4": FirmwareCategoryRIB,
+		"5": FirmwareCategorySystem,
+	}
+	firmwareCategoryHumanMappings = map[FirmwareCategory]string{
+		FirmwareCategoryOther:   "Other",
+		FirmwareCategoryStorage: "Storage",
+		FirmwareCategoryNIC:     "NIC",
+		FirmwareCategoryRIB:     "Remote Insight Board",
+		FirmwareCategorySystem:  "System",
+	}
+	// firmwareDatePattern matches the date embedded in the version of a system ROM, e.g.
+	// "P70 03.01.2013" or "P89 v2.40 (02/17/2017)".
+	firmwareDatePattern = regexp.MustCompile(`(\d{2})[./](\d{2})[./](\d{4})`)
+)
+
+// Firmware returns the version of each firmware component in the system. Returns a non-nil
+// error if the firmware inventory could not be determined.
+func (m *MIB) Firmware() ([]Firmware, error) {
+	firmware := []Firmware{}
+
+	columns := OIDList{
+		cpqHoFwVerCategory,
+		cpqHoFwVerDisplayName,
+		cpqHoFwVerVersion,
+		cpqHoFwVerLocation,
+	}
+	table, err := traverseTable(m.snmpClient, columns)
+	if err != nil {
+		return []Firmware{}, err
+	}
+
+	// The agent reports 0 in the index column of every entry, so the position of each entry
+	// is used as its ID instead.
+	for i, row := range table {
+		category := parseFirmwareCategory(row[0])
+		name := prettifyString(row[1])
+		version := prettifyString(row[2])
+		location := prettifyString(row[3])
+
+		firmware = append(firmware, Firmware{
+			ID:       i,
+			Name:     name,
+			Version:  version,
+			Date:     parseFirmwareDate(version),
+			Location: location,
+			Category: category,
+		})
+	}
+
+	return firmware, nil
+}
+
+// CompareVersion compares the version of this firmware component with the version of another.
+// Returns -1 if this version is older, 0 if the versions are equal, or 1 if this version is newer.
+// Versions of system ROMs are compared by their release date.
+func (f *Firmware) CompareVersion(other *Firmware) int {
+	if !f.Date.IsZero() && !other.Date.IsZero() {
+		switch {
+		case f.Date.Before(other.Date):
+			return -1
+		case f.Date.After(other.Date):
+			return 1
+		default:
+			return 0
+		}
+	}
+	return CompareVersions(f.Version, other.Version)
+}
+
+// CompareVersions compares two firmware version strings, e.g. "1.22" and "1.26". Returns -1 if a is
+// older than b, 0 if the versions are equal, or 1 if a is newer than b. Numeric parts of the versions
+// are compared numerically and all other parts are compared lexically.
+func CompareVersions(a, b string) int {
+	partsA := splitVersion(a)
+	partsB := splitVersion(b)
+	for i := 0; i < len(partsA) && i < len(partsB); i++ {
+		if c := compareVersionPart(partsA[i], partsB[i]); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case len(partsA) < len(partsB):
+		return -1
+	case len(partsA) > len(partsB):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// splitVersion splits a version string into its parts, e.g. "G.W3" into "G" and "W3".
+func splitVersion(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return !(r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z')
+	})
+}
+
+// compareVersionPart compares a single part of two version strings.
+func compareVersionPart(a, b string) int {
+	numA, errA := strconv.Atoi(a)
+	numB, errB := strconv.Atoi(b)
+	if errA == nil && errB == nil {
+		switch {
+		case numA < numB:
+			return -1
+		case numA > numB:
+			return 1
+		default:
+			return 0
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+// parseFirmwareDate extracts the release date from the version of a system ROM, formatted
+// as month, day and year. Returns the zero time if the version does not contain a date.
+func parseFirmwareDate(version string) time.Time {
+	match := firmwareDatePattern.FindStringSubmatch(version)
+	if match == nil {
+		return time.Time{}
+	}
+	date, err := time.Parse("01/02/2006", match[1]+"/"+match[2]+"/"+match[3])
+	if err != nil {
+		return time.Time{}
+	}
+	return date
+}
+
+func parseFirmwareCategory(s string) FirmwareCategory {
+	category, ok := firmwareCategoryIDMappings[s]
+	if !ok {
+		return FirmwareCategoryUnknown
+	}
+	return category
+}
+
+// String converts the FirmwareCategory to a human readable string.
+func (f *FirmwareCategory) String() string {
+	s, ok := firmwareCategoryHumanMappings[*f]
+	if !ok {
+		return "Unknown"
+	}
+	return s
+}
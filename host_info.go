@@ -4,12 +4,55 @@ import (
 	"github.com/soniah/gosnmp"
 )
 
+// HostOS models the operating system running on the server.
+type HostOS struct {
+	// Name is the name of the host OS, e.g. "Red Hat Enterprise Linux".
+	Name string
+	// Version is the version of the host OS, e.g. "7.5".
+	Version string
+	// Description describes the host OS, e.g. "Red Hat Enterprise Linux Server release 7.5 (Maipo)".
+	Description string
+	// HostName is the host name of the server as configured in the host OS.
+	HostName string
+}
+
+// SystemIdentity models the identity of the server and the HP agents running on it.
+type SystemIdentity struct {
+	// HostOS is the operating system running on the server.
+	HostOS HostOS
+	// SerialNumber is the serial number of the server.
+	SerialNumber string
+	// Model is the model of the server.
+	Model string
+	// GUID is the globally unique identifier of the server. Empty if not reported by the agent.
+	GUID string
+	// UUID is the globally unique identifier of the server in its canonical form, e.g.
+	// "35363037-3933-5355-4533-31363239444E". Empty if not reported by the agent.
+	UUID string
+	// MIBRevision is the revision of the host MIB implemented by the agent, e.g. "1.36".
+	MIBRevision string
+	// AgentVersions contains the version of each installed HP agent keyed by its name.
+	AgentVersions map[string]string
+}
+
 // OIDs defined by the HP MIB that describe the properties of the system.
 const (
 	cpqSiSysSerialNum OID = "1.3.6.1.4.1.232.2.2.2.1"
 	cpqSiProductName  OID = "1.3.6.1.4.1.232.2.2.4.2"
 )
 
+// OIDs defined by the HP MIB that describe the host OS and the agent.
+const (
+	cpqHoMibRevMajor   OID = "1.3.6.1.4.1.232.11.1.1"
+	cpqHoMibRevMinor   OID = "1.3.6.1.4.1.232.11.1.2"
+	cpqHoName          OID = "1.3.6.1.4.1.232.11.2.2.1"
+	cpqHoVersion       OID = "1.3.6.1.4.1.232.11.2.2.2"
+	cpqHoDesc          OID = "1.3.6.1.4.1.232.11.2.2.3"
+	cpqHoSystemName    OID = "1.3.6.1.4.1.232.11.2.2.12"
+	cpqHoGUID          OID = "1.3.6.1.4.1.232.11.2.10.3"
+	cpqHoGUIDCanonical OID = "1.3.6.1.4.1.232.11.2.10.6"
+)
+
 // SerialNumber returns the serial number of the server.
 // Returns a non-nil error if the serial number could not be determined.
 func (m *MIB) SerialNumber() (string, error) {
@@ -43,3 +86,69 @@ func (m *MIB) Model() (string, error) {
 	model := string(res.Variables[0].Value.([]byte))
 	return prettifyString(model), nil
 }
+
+// HostOS returns the operating system running on the server.
+// Returns a non-nil error if the host OS could not be determined.
+func (m *MIB) HostOS() (HostOS, error) {
+	values, err := getScalars(m.snmpClient, OIDList{
+		cpqHoName,
+		cpqHoVersion,
+		cpqHoDesc,
+		cpqHoSystemName,
+	})
+	if err != nil {
+		return HostOS{}, err
+	}
+	return HostOS{
+		Name:        prettifyString(values[0]),
+		Version:     prettifyString(values[1]),
+		Description: prettifyString(values[2]),
+		HostName:    prettifyString(values[3]),
+	}, nil
+}
+
+// SystemIdentity returns the identity of the server, its host OS and the HP agents running on it.
+// Returns a non-nil error if the identity could not be determined.
+func (m *MIB) SystemIdentity() (SystemIdentity, error) {
+	hostOS, err := m.HostOS()
+	if err != nil {
+		return SystemIdentity{}, err
+	}
+	serialNo, err := m.SerialNumber()
+	if err != nil {
+		return SystemIdentity{}, err
+	}
+	model, err := m.Model()
+	if err != nil {
+		return SystemIdentity{}, err
+	}
+	values, err := getScalars(m.snmpClient, OIDList{
+		cpqHoMibRevMajor,
+		cpqHoMibRevMinor,
+		cpqHoGUID,
+		cpqHoGUIDCanonical,
+	})
+	if err != nil {
+		return SystemIdentity{}, err
+	}
+	software, err := m.Software()
+	if err != nil {
+		return SystemIdentity{}, err
+	}
+	agentVersions := map[string]string{}
+	for _, s := range software {
+		if s.Type == SoftwareTypeAgent {
+			agentVersions[s.Name] = s.Version
+		}
+	}
+
+	return SystemIdentity{
+		HostOS:        hostOS,
+		SerialNumber:  serialNo,
+		Model:         model,
+		GUID:          prettifyString(values[2]),
+		UUID:          prettifyString(values[3]),
+		MIBRevision:   values[0] + "." + values[1],
+		AgentVersions: agentVersions,
+	}, nil
+}
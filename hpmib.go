@@ -101,26 +101,45 @@ type OIDList []OID
 
 // A StatusChecker queries the HP MIB for device status information.
 type StatusChecker interface {
+	AdvancedMemoryProtection() (MemoryProtectionMode, error)
 	ArrayAccelerators() ([]ArrayAccelerator, error)
+	ASR() (ASR, error)
 	ASRStatus() (Status, error)
 	BackupBatteryStatus() (Status, error)
 	Controllers() ([]Controller, error)
 	ControllerStatus() (Status, error)
+	DIMMs() ([]DIMM, error)
+	DiskIOStats() ([]DiskIOStats, error)
 	DriveArrayStatus() (Status, error)
 	EnclosureStatus() (Status, error)
 	Fans() ([]Fan, error)
 	FanStatus() (Status, error)
+	FileSystems() ([]FileSystem, error)
+	Firmware() ([]Firmware, error)
+	HostOS() (HostOS, error)
+	InterfaceStats() ([]InterfaceStats, error)
 	LogicalDrives() ([]LogicalDrive, error)
+	MemoryBoards() ([]MemoryBoard, error)
 	MemoryModules() ([]MemoryModule, error)
 	MemoryStatus() (Status, error)
 	Model() (string, error)
+	NetworkAdapters() ([]NetworkAdapter, error)
+	PhysicalDriveErrors() ([]PhysicalDriveErrors, error)
 	PhysicalDrives() ([]PhysicalDrive, error)
+	PhysicalDriveThresholds() ([]PhysicalDriveThreshold, error)
+	PowerMeter() (PowerMeter, error)
 	PowerMeterReading() (int, error)
 	PowerSupplies() ([]PowerSupply, error)
 	PowerSupplyStatus() (Status, error)
 	Processors() ([]Processor, error)
 	ProcessorStatus() (Status, error)
+	ProcessorUtilization() ([]ProcessorUtilization, error)
 	SerialNumber() (string, error)
+	Software() ([]Software, error)
+	SpareDrives() ([]SpareDrive, error)
+	StorageEnclosures() ([]StorageEnclosure, error)
+	SystemIdentity() (SystemIdentity, error)
+	SystemInfo() (SystemInfo, error)
 	TemperatureSensors() ([]TemperatureSensor, error)
 	TemperatureSensorStatus() (Status, error)
 }
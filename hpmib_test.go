@@ -0,0 +1,501 @@
+package hpmib
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNetworkAdapterStatistics_Rates(t *testing.T) {
+	previous := &NetworkAdapterStatistics{GoodReceives: 1000, InOctets: 4294967000, FCSErrors: 7}
+	current := &NetworkAdapterStatistics{GoodReceives: 3000, InOctets: 704, FCSErrors: 7}
+
+	rates, err := current.Rates(previous, 10*time.Second)
+	require.NoError(t, err, "failed to compute network adapter rates")
+	assert.Equal(t, NetworkAdapterRates{GoodReceives: 200, InOctets: 100}, rates)
+
+	_, err = current.Rates(previous, 0)
+	assert.Equal(t, ErrInvalidSampleInterval, err)
+}
+
+func TestParseIfNumber(t *testing.T) {
+	tests := []struct {
+		Value    string
+		Expected int
+	}{
+		{Value: "\x02\x00\x00\x00", Expected: 2},
+		{Value: "\x01\x01\x00\x00", Expected: 257},
+		{Value: "\x03\x00\x00\x00\x00\x00\x00\x00", Expected: 3},
+		{Value: "\x02\x00", Expected: -1},
+		{Value: "", Expected: -1},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.Expected, parseIfNumber(test.Value), "parsing %q", test.Value)
+	}
+}
+
+func TestParseNetworkAdapter_InterfaceName(t *testing.T) {
+	names, err := parseInterfaceNames([][]string{{"1", "lo"}, {"2", " eth0 "}, {"3", "eth1"}})
+	require.NoError(t, err, "failed to parse interface names")
+	assert.Equal(t, map[int]string{1: "lo", 2: "eth0", 3: "eth1"}, names)
+
+	row := []string{"1", "\x03\x00\x00\x00", "\x00\x17\xa4\x77\x00\x1c", "3", "2", "2", "1", "1000", "HP NC382i DP Multifunction Gigabit Server Adapter"}
+	for i := 0; i < 17; i++ {
+		row = append(row, "0")
+	}
+	adapter, err := parseNetworkAdapter(row, names)
+	require.NoError(t, err, "failed to parse network adapter")
+	assert.Equal(t, 3, adapter.IfIndex)
+	assert.Equal(t, "eth1", adapter.InterfaceName)
+	assert.Equal(t, "00:17:a4:77:00:1c", adapter.MACAddress)
+
+	row[1] = "\x09\x00\x00\x00"
+	adapter, err = parseNetworkAdapter(row, names)
+	require.NoError(t, err, "failed to parse network adapter")
+	assert.Equal(t, 9, adapter.IfIndex)
+	assert.Equal(t, "", adapter.InterfaceName)
+
+	_, err = parseInterfaceNames([][]string{{"x", "eth0"}})
+	assert.Error(t, err)
+}
+
+func TestParseInterfaceStats_JoinNetworkAdapter(t *testing.T) {
+	names, err := parseInterfaceNames([][]string{{"1", "lo"}, {"2", "eth0"}, {"3", "eth1"}})
+	require.NoError(t, err, "failed to parse interface names")
+
+	adapters := []NetworkAdapter{}
+	for i, ifNumber := range []string{"\x02\x00\x00\x00", "\x03\x00\x00\x00"} {
+		row := []string{strconv.Itoa(i + 1), ifNumber, "\x00\x17\xa4\x77\x00\x1c", "3", "2", "2", "1", "1000", "HP NC382i"}
+		for j := 0; j < 17; j++ {
+			row = append(row, "0")
+		}
+		adapter, err := parseNetworkAdapter(row, names)
+		require.NoError(t, err, "failed to parse network adapter")
+		adapters = append(adapters, adapter)
+	}
+
+	rows := [][]string{
+		{"1", "lo", "0", "0", "0", "0", "0", "0", "0", "0"},
+		{"2", "eth1", "3000", "30", "100", "1", "6000", "60", "200", "2"},
+		{"3", "eth0", "1500", "15", "50", "0", "4500", "45", "150", "1"},
+	}
+	stats := map[string]InterfaceStats{}
+	for _, row := range rows {
+		stat, err := parseInterfaceStats(row)
+		require.NoError(t, err, "failed to parse interface stats")
+		stats[stat.Name] = stat
+	}
+
+	require.Contains(t, stats, adapters[0].InterfaceName)
+	assert.Equal(t, 3, stats[adapters[0].InterfaceName].ID)
+	assert.Equal(t, uint32(50), stats[adapters[0].InterfaceName].RxBytesPerSec)
+	require.Contains(t, stats, adapters[1].InterfaceName)
+	assert.Equal(t, 2, stats[adapters[1].InterfaceName].ID)
+	assert.Equal(t, uint32(200), stats[adapters[1].InterfaceName].TxBytesPerSec)
+
+	_, err = parseInterfaceStats([]string{"4", "eth2", "x", "0", "0", "0", "0", "0", "0", "0"})
+	assert.Error(t, err)
+}
+
+func TestParsePhysicalDriveThreshold(t *testing.T) {
+	tests := []struct {
+		Row      []string
+		Expected PhysicalDriveThreshold
+	}{
+		{
+			Row: []string{"0", "1", "1", "15", "0", "20", "20"},
+			Expected: PhysicalDriveThreshold{
+				ControllerID: 0,
+				DriveID:      1,
+				ID:           1,
+				Parameter:    15,
+				Threshold:    20,
+				Value:        20,
+			},
+		},
+		{
+			Row: []string{"1", "3", "2", "10", "1", "5", "7"},
+			Expected: PhysicalDriveThreshold{
+				ControllerID: 1,
+				DriveID:      3,
+				ID:           2,
+				Parameter:    10,
+				Threshold:    5,
+				Value:        7,
+				Exceeded:     true,
+			},
+		},
+		{
+			Row: []string{"0", "2", "1", "99", "2", "0", "0"},
+			Expected: PhysicalDriveThreshold{
+				ControllerID: 0,
+				DriveID:      2,
+				ID:           1,
+				Parameter:    99,
+				Exceeded:     true,
+			},
+		},
+	}
+
+	thresholds := []PhysicalDriveThreshold{}
+	for _, test := range tests {
+		threshold, err := parsePhysicalDriveThreshold(test.Row)
+		require.NoError(t, err, "failed to parse threshold row %v", test.Row)
+		assert.Equal(t, test.Expected, threshold)
+		thresholds = append(thresholds, threshold)
+	}
+	assert.Equal(t, []PhysicalDriveThreshold{tests[1].Expected, tests[2].Expected}, ExceededThresholds(thresholds))
+
+	_, err := parsePhysicalDriveThreshold([]string{"0", "1", "1", "15", "", "20", "20"})
+	assert.Error(t, err)
+}
+
+func TestResolveThresholdDriveIDs(t *testing.T) {
+	drives := []PhysicalDrive{
+		{ControllerID: 0, ID: 8},
+		{ControllerID: 0, ID: 9},
+		{ControllerID: 1, ID: 0},
+	}
+	thresholds := []PhysicalDriveThreshold{
+		{ControllerID: 0, DriveID: 1},
+		{ControllerID: 0, DriveID: 2},
+		{ControllerID: 1, DriveID: 1},
+		{ControllerID: 1, DriveID: 2},
+		{ControllerID: 2, DriveID: 1},
+		{ControllerID: 0, DriveID: 0},
+	}
+
+	resolveThresholdDriveIDs(thresholds, drives)
+	ids := []int{}
+	for _, threshold := range thresholds {
+		ids = append(ids, threshold.DriveID)
+	}
+	assert.Equal(t, []int{8, 9, 0, -1, -1, -1}, ids)
+}
+
+func TestParseSpareDrives(t *testing.T) {
+	table := [][]string{
+		{"0", "4", "4"},
+		{"0", "5", "5"},
+		{"0", "6", "6"},
+		{"1", "2", "2"},
+	}
+	optional := map[OID]map[string]string{
+		cpqDaSpareReplacedDrv: {
+			"0.4": "0",
+			"0.5": "1",
+			"0.6": "3",
+		},
+		cpqDaSpareCondition: {
+			"0.4": "2",
+			"0.5": "3",
+			"0.6": "2",
+		},
+		cpqDaSparePercentRebuild: {
+			"0.4": "4294967295",
+			"0.5": "40",
+			"0.6": "4294967295",
+		},
+	}
+	logicalDrives := []LogicalDrive{
+		{
+			ControllerID:      0,
+			ID:                1,
+			AvailableSpares:   []int{4, 5},
+			PhysicalDriveIDs:  []int{0, 1},
+			SpareReplacements: map[int]int{},
+		},
+		{
+			ControllerID:      0,
+			ID:                2,
+			AvailableSpares:   []int{4, 6},
+			PhysicalDriveIDs:  []int{2, 3},
+			SpareReplacements: map[int]int{3: 6},
+		},
+	}
+	expected := []SpareDrive{
+		{
+			ControllerID:            0,
+			PhysicalDriveID:         4,
+			Status:                  SpareDriveStatusInactive,
+			Condition:               StatusOK,
+			AssignedLogicalDriveIDs: []int{1, 2},
+			LogicalDriveID:          -1,
+			ReplacedDriveID:         -1,
+			PercentRebuild:          -1,
+		},
+		{
+			ControllerID:            0,
+			PhysicalDriveID:         5,
+			Status:                  SpareDriveStatusBuilding,
+			Condition:               StatusDegraded,
+			AssignedLogicalDriveIDs: []int{1},
+			LogicalDriveID:          1,
+			ReplacedDriveID:         1,
+			PercentRebuild:          40,
+		},
+		{
+			ControllerID:            0,
+			PhysicalDriveID:         6,
+			Status:                  SpareDriveStatusActive,
+			Condition:               StatusOK,
+			AssignedLogicalDriveIDs: []int{2},
+			LogicalDriveID:          2,
+			ReplacedDriveID:         3,
+			PercentRebuild:          -1,
+		},
+		{
+			ControllerID:            1,
+			PhysicalDriveID:         2,
+			Status:                  SpareDriveStatusInvalid,
+			Condition:               StatusUnknown,
+			AssignedLogicalDriveIDs: []int{},
+			LogicalDriveID:          -1,
+			ReplacedDriveID:         -1,
+			PercentRebuild:          -1,
+		},
+	}
+
+	spareDrives, err := parseSpareDrives(table, optional, logicalDrives)
+	require.NoError(t, err, "failed to parse spare drives")
+	assert.Equal(t, expected, spareDrives)
+
+	_, err = parseSpareDrives([][]string{{"0", "x", "4"}}, optional, logicalDrives)
+	assert.Error(t, err)
+}
+
+func TestParseDIMM(t *testing.T) {
+	row := []string{"0", "3", "16777216", "19", "2", "7", "HP", " 752369-081 ", "1A2B3C4D", "3", "2133"}
+	expected := DIMM{
+		BoardID:       0,
+		ID:            3,
+		SizeKB:        16777216,
+		Type:          DIMMTypeDDR4,
+		ECCStatus:     DIMMECCStatusOK,
+		Technology:    DIMMTechnologyRegistered,
+		Manufacturer:  "HP",
+		PartNo:        "752369-081",
+		SerialNo:      "1A2B3C4D",
+		SpeedMHz:      2133,
+		HPSmartMemory: true,
+	}
+
+	dimm, err := parseDIMM(row)
+	require.NoError(t, err, "failed to parse DIMM row")
+	assert.Equal(t, expected, dimm)
+
+	row[4], row[9] = "0", "2"
+	dimm, err = parseDIMM(row)
+	require.NoError(t, err, "failed to parse DIMM row")
+	assert.Equal(t, DIMMECCStatusUnknown, dimm.ECCStatus)
+	assert.False(t, dimm.HPSmartMemory)
+}
+
+func TestDiskIOStats_Rates(t *testing.T) {
+	previous := &DiskIOStats{Reads: 4294967290, ReadTimeMs: 100, Writes: 10, WriteSectors: 80}
+	current := &DiskIOStats{Reads: 4, ReadTimeMs: 120, Writes: 10, WriteSectors: 80}
+
+	rates, err := current.Rates(previous, 2*time.Second)
+	require.NoError(t, err, "failed to compute disk I/O rates")
+	assert.Equal(t, DiskIORates{Reads: 5, ReadServiceTimeMs: 2}, rates)
+
+	_, err = current.Rates(previous, -time.Second)
+	assert.Equal(t, ErrInvalidSampleInterval, err)
+}
+
+func TestExcludePseudoFileSystems(t *testing.T) {
+	fileSystems := []FileSystem{
+		{ID: 0, Device: "/dev/mapper/vg-root", MountPoint: "/"},
+		{ID: 1, Device: "securityfs", MountPoint: "/sys/kernel/security"},
+		{ID: 2, Device: "tmpfs", MountPoint: "/dev/shm"},
+		{ID: 3, Device: "cgroup", MountPoint: "/sys/fs/cgroup/devices"},
+		{ID: 4, Device: "hugetlbfs", MountPoint: "/dev/hugepages"},
+		{ID: 5, Device: "/dev/sda1", MountPoint: "/boot"},
+		{ID: 6, Device: "none", MountPoint: "/proc/sys/fs/binfmt_misc"},
+		{ID: 7, Device: "devpts", MountPoint: "/dev/pts"},
+		{ID: 8, Device: "/dev/sdb1", MountPoint: "/system"},
+	}
+	expected := []FileSystem{
+		{ID: 0, Device: "/dev/mapper/vg-root", MountPoint: "/"},
+		{ID: 2, Device: "tmpfs", MountPoint: "/dev/shm"},
+		{ID: 5, Device: "/dev/sda1", MountPoint: "/boot"},
+		{ID: 8, Device: "/dev/sdb1", MountPoint: "/system"},
+	}
+	assert.Equal(t, expected, ExcludePseudoFileSystems(fileSystems))
+}
+
+func TestFirmware_CompareVersion(t *testing.T) {
+	tests := []struct {
+		A        Firmware
+		B        Firmware
+		Expected int
+	}{
+		{A: Firmware{Version: "1.22"}, B: Firmware{Version: "1.26"}, Expected: -1},
+		{A: Firmware{Version: "1.26"}, B: Firmware{Version: "1.26"}, Expected: 0},
+		{A: Firmware{Version: "5.14"}, B: Firmware{Version: "3.54"}, Expected: 1},
+		{A: Firmware{Version: "1.9"}, B: Firmware{Version: "1.10"}, Expected: -1},
+		{A: Firmware{Version: "1.6"}, B: Firmware{Version: "1.6.1"}, Expected: -1},
+		{A: Firmware{Version: "G.W3"}, B: Firmware{Version: "G.W3"}, Expected: 0},
+		{
+			A:        Firmware{Version: "P67 05.05.2011", Date: time.Date(2011, 5, 5, 0, 0, 0, 0, time.UTC)},
+			B:        Firmware{Version: "P70 03.01.2013", Date: time.Date(2013, 3, 1, 0, 0, 0, 0, time.UTC)},
+			Expected: -1,
+		},
+		{
+			A:        Firmware{Version: "P70 03.01.2013", Date: time.Date(2013, 3, 1, 0, 0, 0, 0, time.UTC)},
+			B:        Firmware{Version: "P70 02.10.2012", Date: time.Date(2012, 2, 10, 0, 0, 0, 0, time.UTC)},
+			Expected: 1,
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.Expected, test.A.CompareVersion(&test.B), "comparing %s to %s", test.A.Version, test.B.Version)
+	}
+}
+
+func TestParseROMFamily(t *testing.T) {
+	tests := []struct {
+		Version  string
+		Expected string
+	}{
+		{Version: "05/05/2011, Family P67", Expected: "P67"},
+		{Version: "P89 v2.40 (02/17/2017)", Expected: "P89"},
+		{Version: "05/05/2011", Expected: ""},
+		{Version: "", Expected: ""},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.Expected, parseROMFamily(test.Version), "parsing %q", test.Version)
+	}
+}
+
+func TestTemperatureSensor_HeadroomCelsius(t *testing.T) {
+	tests := []struct {
+		Sensor           TemperatureSensor
+		ExpectedHeadroom int
+		ExpectedOK       bool
+	}{
+		{Sensor: TemperatureSensor{CurrentReadingCelsius: 26, Threshold: 41}, ExpectedHeadroom: 15, ExpectedOK: true},
+		{Sensor: TemperatureSensor{CurrentReadingCelsius: 90, Threshold: 87}, ExpectedHeadroom: -3, ExpectedOK: true},
+		{Sensor: TemperatureSensor{CurrentReadingCelsius: 0, Threshold: -99}, ExpectedHeadroom: 0, ExpectedOK: false},
+	}
+
+	for _, test := range tests {
+		headroom, ok := test.Sensor.HeadroomCelsius()
+		assert.Equal(t, test.ExpectedHeadroom, headroom)
+		assert.Equal(t, test.ExpectedOK, ok)
+	}
+}
+
+func TestParseTemperatureSensor(t *testing.T) {
+	locations := map[string]string{"0.1": " 01-Inlet Ambient ", "0.2": "02-CPU 1"}
+	tests := []struct {
+		Row      []string
+		Expected TemperatureSensor
+	}{
+		{
+			Row: []string{"1", "11", "21", "42", "2", "9", "0"},
+			Expected: TemperatureSensor{
+				ID:                    1,
+				CurrentReadingCelsius: 21,
+				Locale:                TemperatureSensorLocaleAmbient,
+				Status:                StatusOK,
+				Threshold:             42,
+				ThresholdType:         TemperatureSensorThresholdTypeCaution,
+				Location:              "01-Inlet Ambient",
+			},
+		},
+		{
+			Row: []string{"2", "6", "40", "70", "2", "15", "0"},
+			Expected: TemperatureSensor{
+				ID:                    2,
+				CurrentReadingCelsius: 40,
+				Locale:                TemperatureSensorLocaleCPU,
+				Status:                StatusOK,
+				Threshold:             70,
+				ThresholdType:         TemperatureSensorThresholdTypeCritical,
+				Location:              "02-CPU 1",
+			},
+		},
+		{
+			Row: []string{"3", "7", "30", "87", "2", "9", "0"},
+			Expected: TemperatureSensor{
+				ID:                    3,
+				CurrentReadingCelsius: 30,
+				Locale:                TemperatureSensorLocaleMemory,
+				Status:                StatusOK,
+				Threshold:             87,
+				ThresholdType:         TemperatureSensorThresholdTypeCaution,
+				Location:              "",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		sensor, err := parseTemperatureSensor(test.Row, locations)
+		require.NoError(t, err, "failed to parse temperature sensor")
+		assert.Equal(t, test.Expected, sensor)
+	}
+
+	_, err := parseTemperatureSensor([]string{"4", "7", "x", "87", "2", "9", "0"}, locations)
+	assert.Error(t, err)
+}
+
+func TestParseControllerModel(t *testing.T) {
+	tests := []struct {
+		ID       string
+		Expected string
+	}{
+		{ID: "15", Expected: "Integrated Smart Array"},
+		{ID: "45", Expected: "StorageWorks 1210m"},
+		{ID: "49", Expected: "Smart Array P420i"},
+		{ID: "58", Expected: "Smart Array P822se"},
+		{ID: "94", Expected: "Smart Array P408i-a"},
+		{ID: "28", Expected: "Unknown"},
+		{ID: "", Expected: "Unknown"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.Expected, parseControllerModel(test.ID), "parsing %q", test.ID)
+	}
+}
+
+func TestParseArrayAcceleratorError(t *testing.T) {
+	tests := []struct {
+		Value    string
+		Expected ArrayAcceleratorError
+	}{
+		{Value: "2", Expected: ArrayAcceleratorErrorNone},
+		{Value: "18", Expected: ArrayAcceleratorErrorADGEnablerMissing},
+		{Value: "26", Expected: ArrayAcceleratorErrorCapBasedBackupFailed},
+		{Value: "31", Expected: ArrayAcceleratorErrorCacheModuleHotRemoved},
+		{Value: "-1", Expected: ArrayAcceleratorErrorUnknown},
+		{Value: "99", Expected: ArrayAcceleratorErrorUnknown},
+		{Value: "", Expected: ArrayAcceleratorErrorUnknown},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.Expected, parseArrayAcceleratorError(test.Value), "parsing %q", test.Value)
+	}
+}
+
+func TestParseSoftwareDate(t *testing.T) {
+	tests := []struct {
+		Value    string
+		Expected time.Time
+	}{
+		{Value: "\x07\xe1\x03\x0e\x09\x1e\x05", Expected: time.Date(2017, 3, 14, 9, 30, 5, 0, time.UTC)},
+		{Value: "\x07\xdc\x0c\x1f\x17\x3b\x3b", Expected: time.Date(2012, 12, 31, 23, 59, 59, 0, time.UTC)},
+		{Value: "\x00\x00\x00\x00\x00\x00\x00", Expected: time.Time{}},
+		{Value: "\x07\xe1\x03", Expected: time.Time{}},
+		{Value: "", Expected: time.Time{}},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.Expected, parseSoftwareDate(test.Value), "parsing %q", test.Value)
+	}
+}
@@ -4,6 +4,7 @@ package hpmib
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -73,7 +74,15 @@ func TestMIB_ArrayAccelerators(t *testing.T) {
 					Status:             StatusOK,
 					BatteryStatus:      BatteryStatusOK,
 					SerialNumber:       "PBCDF0CRH1K8GA",
-					FailedBatterySlots: "",
+					FailedBatterySlots: []int{},
+					CacheStatus:        ArrayAcceleratorCacheStatusEnabled,
+					ErrorCode:          ArrayAcceleratorErrorNone,
+					ReadErrors:         0,
+					WriteErrors:        0,
+					TotalCacheMB:       1024,
+					ReadCacheMB:        256,
+					WriteCacheMB:       768,
+					BackupPowerSource:  BackupPowerSourceCapacitors,
 				},
 			},
 		},
@@ -86,7 +95,15 @@ func TestMIB_ArrayAccelerators(t *testing.T) {
 					Status:             StatusOK,
 					BatteryStatus:      BatteryStatusOK,
 					SerialNumber:       "PBKUD0ARH2D0AV",
-					FailedBatterySlots: "",
+					FailedBatterySlots: []int{},
+					CacheStatus:        ArrayAcceleratorCacheStatusEnabled,
+					ErrorCode:          ArrayAcceleratorErrorNone,
+					ReadErrors:         0,
+					WriteErrors:        0,
+					TotalCacheMB:       2048,
+					ReadCacheMB:        204,
+					WriteCacheMB:       1843,
+					BackupPowerSource:  BackupPowerSourceCapacitors,
 				},
 			},
 		},
@@ -111,11 +128,20 @@ func TestMIB_Controllers(t *testing.T) {
 			Generation: 7,
 			Expected: []Controller{
 				{
-					ID:       0,
-					SlotNo:   0,
-					SerialNo: "500143801756DC50",
-					Status:   StatusOK,
-					Location: "Slot 0",
+					ID:               0,
+					SlotNo:           0,
+					SerialNo:         "500143801756DC50",
+					Status:           StatusOK,
+					Location:         "Slot 0",
+					Model:            "Smart Array P410i",
+					FirmwareRev:      "5.14",
+					BoardStatus:      ControllerBoardStatusOK,
+					BoardCondition:   StatusOK,
+					Role:             ControllerRoleNotDuplexed,
+					DriveWriteCache:  DriveWriteCacheStateDisabled,
+					EncryptionStatus: ControllerEncryptionStatusOther,
+					CacheSizeMB:      1024,
+					CacheSerialNo:    "PBCDF0CRH1K8GA",
 				},
 			},
 		},
@@ -124,11 +150,20 @@ func TestMIB_Controllers(t *testing.T) {
 			Generation: 8,
 			Expected: []Controller{
 				{
-					ID:       0,
-					SlotNo:   0,
-					SerialNo: "50014380210B3DD0",
-					Status:   StatusOK,
-					Location: "Slot 0",
+					ID:               0,
+					SlotNo:           0,
+					SerialNo:         "50014380210B3DD0",
+					Status:           StatusOK,
+					Location:         "Slot 0",
+					Model:            "Smart Array P420i",
+					FirmwareRev:      "3.54",
+					BoardStatus:      ControllerBoardStatusOK,
+					BoardCondition:   StatusOK,
+					Role:             ControllerRoleNotDuplexed,
+					DriveWriteCache:  DriveWriteCacheStateDisabled,
+					EncryptionStatus: ControllerEncryptionStatusOther,
+					CacheSizeMB:      2048,
+					CacheSerialNo:    "PBKUD0ARH2D0AV",
 				},
 			},
 		},
@@ -155,23 +190,37 @@ func TestMIB_LogicalDrives(t *testing.T) {
 			Generation: 7,
 			Expected: []LogicalDrive{
 				{
-					ID:              1,
-					Name:            "/dev/sda",
-					AvailableSpares: []string{},
-					ControllerID:    0,
-					CapacityMB:      953837,
-					Condition:       StatusOK,
-					Status:          LogicalDriveStatusOK,
-					FaultTolerance:  FaultToleranceMirroring,
-				},
-				{
-					ID:              2,
-					Name:            "/dev/sdb",
-					AvailableSpares: []string{},
-					CapacityMB:      953837,
-					Condition:       StatusOK,
-					Status:          LogicalDriveStatusOK,
-					FaultTolerance:  FaultToleranceMirroring,
+					ID:                1,
+					Name:              "/dev/sda",
+					AvailableSpares:   []int{},
+					ControllerID:      0,
+					CapacityMB:        953837,
+					Condition:         StatusOK,
+					Status:            LogicalDriveStatusOK,
+					FaultTolerance:    FaultToleranceMirroring,
+					PercentRebuild:    -1,
+					RebuildingDriveID: -1,
+					StripSizeKB:       256,
+					StripeSizeKB:      256,
+					CacheStatus:       LogicalDriveCacheStatusEnabled,
+					PhysicalDriveIDs:  []int{0, 1},
+					SpareReplacements: map[int]int{},
+				},
+				{
+					ID:                2,
+					Name:              "/dev/sdb",
+					AvailableSpares:   []int{},
+					CapacityMB:        953837,
+					Condition:         StatusOK,
+					Status:            LogicalDriveStatusOK,
+					FaultTolerance:    FaultToleranceMirroring,
+					PercentRebuild:    -1,
+					RebuildingDriveID: -1,
+					StripSizeKB:       256,
+					StripeSizeKB:      256,
+					CacheStatus:       LogicalDriveCacheStatusEnabled,
+					PhysicalDriveIDs:  []int{2, 3},
+					SpareReplacements: map[int]int{},
 				},
 			},
 		},
@@ -180,23 +229,37 @@ func TestMIB_LogicalDrives(t *testing.T) {
 			Generation: 8,
 			Expected: []LogicalDrive{
 				{
-					ID:              1,
-					Name:            "/dev/sda",
-					AvailableSpares: []string{},
-					ControllerID:    0,
-					CapacityMB:      572293,
-					Condition:       StatusOK,
-					Status:          LogicalDriveStatusOK,
-					FaultTolerance:  FaultToleranceMirroring,
-				},
-				{
-					ID:              2,
-					Name:            "/dev/sdb",
-					AvailableSpares: []string{},
-					CapacityMB:      572293,
-					Condition:       StatusOK,
-					Status:          LogicalDriveStatusOK,
-					FaultTolerance:  FaultToleranceMirroring,
+					ID:                1,
+					Name:              "/dev/sda",
+					AvailableSpares:   []int{},
+					ControllerID:      0,
+					CapacityMB:        572293,
+					Condition:         StatusOK,
+					Status:            LogicalDriveStatusOK,
+					FaultTolerance:    FaultToleranceMirroring,
+					PercentRebuild:    -1,
+					RebuildingDriveID: -1,
+					StripSizeKB:       256,
+					StripeSizeKB:      256,
+					CacheStatus:       LogicalDriveCacheStatusEnabled,
+					PhysicalDriveIDs:  []int{8, 9},
+					SpareReplacements: map[int]int{},
+				},
+				{
+					ID:                2,
+					Name:              "/dev/sdb",
+					AvailableSpares:   []int{},
+					CapacityMB:        572293,
+					Condition:         StatusOK,
+					Status:            LogicalDriveStatusOK,
+					FaultTolerance:    FaultToleranceMirroring,
+					PercentRebuild:    -1,
+					RebuildingDriveID: -1,
+					StripSizeKB:       256,
+					StripeSizeKB:      256,
+					CacheStatus:       LogicalDriveCacheStatusEnabled,
+					PhysicalDriveIDs:  []int{10, 11},
+					SpareReplacements: map[int]int{},
 				},
 			},
 		},
@@ -223,48 +286,96 @@ func TestMIB_PhysicalDrives(t *testing.T) {
 			Generation: 7,
 			Expected: []PhysicalDrive{
 				{
-					ID:           0,
-					ControllerID: 0,
-					CapacityMB:   953869,
-					MediaType:    MediaTypeRotatingPlatter,
-					Location:     "Port 1I Box 1 Bay 1",
-					Model:        "ATA ST91000640NS",
-					SerialNo:     "9XG3P718",
-					SMARTStatus:  SMARTStatusOK,
-					Status:       PhysicalDriveStatusOK,
-				},
-				{
-					ID:           1,
-					ControllerID: 0,
-					CapacityMB:   953869,
-					MediaType:    MediaTypeRotatingPlatter,
-					Location:     "Port 1I Box 1 Bay 2",
-					Model:        "ATA ST91000640NS",
-					SerialNo:     "9XG3V13S",
-					SMARTStatus:  SMARTStatusOK,
-					Status:       PhysicalDriveStatusOK,
-				},
-				{
-					ID:           2,
-					ControllerID: 0,
-					CapacityMB:   953869,
-					MediaType:    MediaTypeRotatingPlatter,
-					Location:     "Port 1I Box 1 Bay 3",
-					Model:        "ATA ST91000640NS",
-					SerialNo:     "9XG3TS6B",
-					SMARTStatus:  SMARTStatusOK,
-					Status:       PhysicalDriveStatusOK,
-				},
-				{
-					ID:           3,
-					ControllerID: 0,
-					CapacityMB:   953869,
-					MediaType:    MediaTypeRotatingPlatter,
-					Location:     "Port 1I Box 1 Bay 4",
-					Model:        "ATA ST91000640NS",
-					SerialNo:     "9XG3WE7M",
-					SMARTStatus:  SMARTStatusOK,
-					Status:       PhysicalDriveStatusOK,
+					ID:                      0,
+					ControllerID:            0,
+					CapacityMB:              953869,
+					MediaType:               MediaTypeRotatingPlatter,
+					Location:                "Port 1I Box 1 Bay 1",
+					Model:                   "ATA ST91000640NS",
+					SerialNo:                "9XG3P718",
+					SMARTStatus:             SMARTStatusOK,
+					Status:                  PhysicalDriveStatusOK,
+					FirmwareRev:             "AA09",
+					InterfaceType:           PhysicalDriveInterfaceTypeSATA,
+					RotationalSpeed:         RotationalSpeed7200RPM,
+					Port:                    "1I",
+					Box:                     1,
+					Bay:                     1,
+					CurrentTemperatureC:     44,
+					MaximumTemperatureC:     52,
+					TemperatureThresholdC:   55,
+					RefHours:                27420,
+					SSDEnduranceUsedPercent: -1,
+					SSDUsageRemainingHours:  -1,
+				},
+				{
+					ID:                      1,
+					ControllerID:            0,
+					CapacityMB:              953869,
+					MediaType:               MediaTypeRotatingPlatter,
+					Location:                "Port 1I Box 1 Bay 2",
+					Model:                   "ATA ST91000640NS",
+					SerialNo:                "9XG3V13S",
+					SMARTStatus:             SMARTStatusOK,
+					Status:                  PhysicalDriveStatusOK,
+					FirmwareRev:             "AA09",
+					InterfaceType:           PhysicalDriveInterfaceTypeSATA,
+					RotationalSpeed:         RotationalSpeed7200RPM,
+					Port:                    "1I",
+					Box:                     1,
+					Bay:                     2,
+					CurrentTemperatureC:     45,
+					MaximumTemperatureC:     51,
+					TemperatureThresholdC:   55,
+					RefHours:                27420,
+					SSDEnduranceUsedPercent: -1,
+					SSDUsageRemainingHours:  -1,
+				},
+				{
+					ID:                      2,
+					ControllerID:            0,
+					CapacityMB:              953869,
+					MediaType:               MediaTypeRotatingPlatter,
+					Location:                "Port 1I Box 1 Bay 3",
+					Model:                   "ATA ST91000640NS",
+					SerialNo:                "9XG3TS6B",
+					SMARTStatus:             SMARTStatusOK,
+					Status:                  PhysicalDriveStatusOK,
+					FirmwareRev:             "AA09",
+					InterfaceType:           PhysicalDriveInterfaceTypeSATA,
+					RotationalSpeed:         RotationalSpeed7200RPM,
+					Port:                    "1I",
+					Box:                     1,
+					Bay:                     3,
+					CurrentTemperatureC:     44,
+					MaximumTemperatureC:     53,
+					TemperatureThresholdC:   55,
+					RefHours:                27420,
+					SSDEnduranceUsedPercent: -1,
+					SSDUsageRemainingHours:  -1,
+				},
+				{
+					ID:                      3,
+					ControllerID:            0,
+					CapacityMB:              953869,
+					MediaType:               MediaTypeRotatingPlatter,
+					Location:                "Port 1I Box 1 Bay 4",
+					Model:                   "ATA ST91000640NS",
+					SerialNo:                "9XG3WE7M",
+					SMARTStatus:             SMARTStatusOK,
+					Status:                  PhysicalDriveStatusOK,
+					FirmwareRev:             "AA09",
+					InterfaceType:           PhysicalDriveInterfaceTypeSATA,
+					RotationalSpeed:         RotationalSpeed7200RPM,
+					Port:                    "1I",
+					Box:                     1,
+					Bay:                     4,
+					CurrentTemperatureC:     41,
+					MaximumTemperatureC:     52,
+					TemperatureThresholdC:   55,
+					RefHours:                27420,
+					SSDEnduranceUsedPercent: -1,
+					SSDUsageRemainingHours:  -1,
 				},
 			},
 		},
@@ -273,48 +384,96 @@ func TestMIB_PhysicalDrives(t *testing.T) {
 			Generation: 8,
 			Expected: []PhysicalDrive{
 				{
-					ID:           8,
-					ControllerID: 0,
-					CapacityMB:   572325,
-					MediaType:    MediaTypeRotatingPlatter,
-					Location:     "Port 1I Box 1 Bay 1",
-					Model:        "HP EG0600FBLSH",
-					SerialNo:     "6XR49KJK0000M334J34K",
-					SMARTStatus:  SMARTStatusOK,
-					Status:       PhysicalDriveStatusOK,
-				},
-				{
-					ID:           9,
-					ControllerID: 0,
-					CapacityMB:   572325,
-					MediaType:    MediaTypeRotatingPlatter,
-					Location:     "Port 1I Box 1 Bay 2",
-					Model:        "HP EG0600FBLSH",
-					SerialNo:     "6XR49LC90000B236LZEM",
-					SMARTStatus:  SMARTStatusOK,
-					Status:       PhysicalDriveStatusOK,
-				},
-				{
-					ID:           10,
-					ControllerID: 0,
-					CapacityMB:   572325,
-					MediaType:    MediaTypeRotatingPlatter,
-					Location:     "Port 1I Box 1 Bay 3",
-					Model:        "HP EG0600FBDSR",
-					SerialNo:     "EA01PD31TYY11310",
-					SMARTStatus:  SMARTStatusOK,
-					Status:       PhysicalDriveStatusOK,
-				},
-				{
-					ID:           11,
-					ControllerID: 0,
-					CapacityMB:   572325,
-					MediaType:    MediaTypeRotatingPlatter,
-					Location:     "Port 1I Box 1 Bay 4",
-					Model:        "HP EG0600FBDSR",
-					SerialNo:     "EA01PD31U0BK1310",
-					SMARTStatus:  SMARTStatusOK,
-					Status:       PhysicalDriveStatusOK,
+					ID:                      8,
+					ControllerID:            0,
+					CapacityMB:              572325,
+					MediaType:               MediaTypeRotatingPlatter,
+					Location:                "Port 1I Box 1 Bay 1",
+					Model:                   "HP EG0600FBLSH",
+					SerialNo:                "6XR49KJK0000M334J34K",
+					SMARTStatus:             SMARTStatusOK,
+					Status:                  PhysicalDriveStatusOK,
+					FirmwareRev:             "HPD7",
+					InterfaceType:           PhysicalDriveInterfaceTypeSAS,
+					RotationalSpeed:         RotationalSpeed10000RPM,
+					Port:                    "1I",
+					Box:                     1,
+					Bay:                     1,
+					CurrentTemperatureC:     34,
+					MaximumTemperatureC:     44,
+					TemperatureThresholdC:   60,
+					RefHours:                34961,
+					SSDEnduranceUsedPercent: -1,
+					SSDUsageRemainingHours:  -1,
+				},
+				{
+					ID:                      9,
+					ControllerID:            0,
+					CapacityMB:              572325,
+					MediaType:               MediaTypeRotatingPlatter,
+					Location:                "Port 1I Box 1 Bay 2",
+					Model:                   "HP EG0600FBLSH",
+					SerialNo:                "6XR49LC90000B236LZEM",
+					SMARTStatus:             SMARTStatusOK,
+					Status:                  PhysicalDriveStatusOK,
+					FirmwareRev:             "HPD7",
+					InterfaceType:           PhysicalDriveInterfaceTypeSAS,
+					RotationalSpeed:         RotationalSpeed10000RPM,
+					Port:                    "1I",
+					Box:                     1,
+					Bay:                     2,
+					CurrentTemperatureC:     36,
+					MaximumTemperatureC:     45,
+					TemperatureThresholdC:   60,
+					RefHours:                34961,
+					SSDEnduranceUsedPercent: -1,
+					SSDUsageRemainingHours:  -1,
+				},
+				{
+					ID:                      10,
+					ControllerID:            0,
+					CapacityMB:              572325,
+					MediaType:               MediaTypeRotatingPlatter,
+					Location:                "Port 1I Box 1 Bay 3",
+					Model:                   "HP EG0600FBDSR",
+					SerialNo:                "EA01PD31TYY11310",
+					SMARTStatus:             SMARTStatusOK,
+					Status:                  PhysicalDriveStatusOK,
+					FirmwareRev:             "HPD6",
+					InterfaceType:           PhysicalDriveInterfaceTypeSAS,
+					RotationalSpeed:         RotationalSpeed10000RPM,
+					Port:                    "1I",
+					Box:                     1,
+					Bay:                     3,
+					CurrentTemperatureC:     35,
+					MaximumTemperatureC:     45,
+					TemperatureThresholdC:   60,
+					RefHours:                34961,
+					SSDEnduranceUsedPercent: -1,
+					SSDUsageRemainingHours:  -1,
+				},
+				{
+					ID:                      11,
+					ControllerID:            0,
+					CapacityMB:              572325,
+					MediaType:               MediaTypeRotatingPlatter,
+					Location:                "Port 1I Box 1 Bay 4",
+					Model:                   "HP EG0600FBDSR",
+					SerialNo:                "EA01PD31U0BK1310",
+					SMARTStatus:             SMARTStatusOK,
+					Status:                  PhysicalDriveStatusOK,
+					FirmwareRev:             "HPD6",
+					InterfaceType:           PhysicalDriveInterfaceTypeSAS,
+					RotationalSpeed:         RotationalSpeed10000RPM,
+					Port:                    "1I",
+					Box:                     1,
+					Bay:                     4,
+					CurrentTemperatureC:     33,
+					MaximumTemperatureC:     42,
+					TemperatureThresholdC:   60,
+					RefHours:                34961,
+					SSDEnduranceUsedPercent: -1,
+					SSDUsageRemainingHours:  -1,
 				},
 			},
 		},
@@ -341,40 +500,82 @@ func TestMIB_Fans(t *testing.T) {
 			Generation: 7,
 			Expected: []Fan{
 				{
-					ID:         1,
-					Locale:     FanLocaleSystem,
-					Redundancy: FanRedundancyRedundant,
-					Status:     StatusOK,
-				},
-				{
-					ID:         2,
-					Locale:     FanLocaleSystem,
-					Redundancy: FanRedundancyRedundant,
-					Status:     StatusOK,
-				},
-				{
-					ID:         3,
-					Locale:     FanLocaleSystem,
-					Redundancy: FanRedundancyRedundant,
-					Status:     StatusOK,
-				},
-				{
-					ID:         4,
-					Locale:     FanLocaleSystem,
-					Redundancy: FanRedundancyRedundant,
-					Status:     StatusOK,
-				},
-				{
-					ID:         5,
-					Locale:     FanLocaleSystem,
-					Redundancy: FanRedundancyRedundant,
-					Status:     StatusOK,
-				},
-				{
-					ID:         6,
-					Locale:     FanLocaleSystem,
-					Redundancy: FanRedundancyRedundant,
-					Status:     StatusOK,
+					ID:                  1,
+					Locale:              FanLocaleSystem,
+					Redundancy:          FanRedundancyRedundant,
+					Status:              StatusOK,
+					ChassisID:           1,
+					Present:             FanPresencePresent,
+					HotPluggable:        FanHotPlugHotPluggable,
+					Speed:               FanSpeedNormal,
+					SpeedPercent:        -1,
+					RedundancyPartnerID: 2,
+					Location:            "",
+				},
+				{
+					ID:                  2,
+					Locale:              FanLocaleSystem,
+					Redundancy:          FanRedundancyRedundant,
+					Status:              StatusOK,
+					ChassisID:           1,
+					Present:             FanPresencePresent,
+					HotPluggable:        FanHotPlugHotPluggable,
+					Speed:               FanSpeedNormal,
+					SpeedPercent:        -1,
+					RedundancyPartnerID: 3,
+					Location:            "",
+				},
+				{
+					ID:                  3,
+					Locale:              FanLocaleSystem,
+					Redundancy:          FanRedundancyRedundant,
+					Status:              StatusOK,
+					ChassisID:           1,
+					Present:             FanPresencePresent,
+					HotPluggable:        FanHotPlugHotPluggable,
+					Speed:               FanSpeedNormal,
+					SpeedPercent:        -1,
+					RedundancyPartnerID: 4,
+					Location:            "",
+				},
+				{
+					ID:                  4,
+					Locale:              FanLocaleSystem,
+					Redundancy:          FanRedundancyRedundant,
+					Status:              StatusOK,
+					ChassisID:           1,
+					Present:             FanPresencePresent,
+					HotPluggable:        FanHotPlugHotPluggable,
+					Speed:               FanSpeedNormal,
+					SpeedPercent:        -1,
+					RedundancyPartnerID: 5,
+					Location:            "",
+				},
+				{
+					ID:                  5,
+					Locale:              FanLocaleSystem,
+					Redundancy:          FanRedundancyRedundant,
+					Status:              StatusOK,
+					ChassisID:           1,
+					Present:             FanPresencePresent,
+					HotPluggable:        FanHotPlugHotPluggable,
+					Speed:               FanSpeedNormal,
+					SpeedPercent:        -1,
+					RedundancyPartnerID: 6,
+					Location:            "",
+				},
+				{
+					ID:                  6,
+					Locale:              FanLocaleSystem,
+					Redundancy:          FanRedundancyRedundant,
+					Status:              StatusOK,
+					ChassisID:           1,
+					Present:             FanPresencePresent,
+					HotPluggable:        FanHotPlugHotPluggable,
+					Speed:               FanSpeedNormal,
+					SpeedPercent:        -1,
+					RedundancyPartnerID: 1,
+					Location:            "",
 				},
 			},
 		},
@@ -383,40 +584,82 @@ func TestMIB_Fans(t *testing.T) {
 			Generation: 8,
 			Expected: []Fan{
 				{
-					ID:         1,
-					Locale:     FanLocaleSystem,
-					Redundancy: FanRedundancyRedundant,
-					Status:     StatusOK,
-				},
-				{
-					ID:         2,
-					Locale:     FanLocaleSystem,
-					Redundancy: FanRedundancyRedundant,
-					Status:     StatusOK,
-				},
-				{
-					ID:         3,
-					Locale:     FanLocaleSystem,
-					Redundancy: FanRedundancyRedundant,
-					Status:     StatusOK,
-				},
-				{
-					ID:         4,
-					Locale:     FanLocaleSystem,
-					Redundancy: FanRedundancyRedundant,
-					Status:     StatusOK,
-				},
-				{
-					ID:         5,
-					Locale:     FanLocaleSystem,
-					Redundancy: FanRedundancyRedundant,
-					Status:     StatusOK,
-				},
-				{
-					ID:         6,
-					Locale:     FanLocaleSystem,
-					Redundancy: FanRedundancyRedundant,
-					Status:     StatusOK,
+					ID:                  1,
+					Locale:              FanLocaleSystem,
+					Redundancy:          FanRedundancyRedundant,
+					Status:              StatusOK,
+					ChassisID:           1,
+					Present:             FanPresencePresent,
+					HotPluggable:        FanHotPlugHotPluggable,
+					Speed:               FanSpeedNormal,
+					SpeedPercent:        -1,
+					RedundancyPartnerID: 2,
+					Location:            "",
+				},
+				{
+					ID:                  2,
+					Locale:              FanLocaleSystem,
+					Redundancy:          FanRedundancyRedundant,
+					Status:              StatusOK,
+					ChassisID:           1,
+					Present:             FanPresencePresent,
+					HotPluggable:        FanHotPlugHotPluggable,
+					Speed:               FanSpeedNormal,
+					SpeedPercent:        -1,
+					RedundancyPartnerID: 3,
+					Location:            "",
+				},
+				{
+					ID:                  3,
+					Locale:              FanLocaleSystem,
+					Redundancy:          FanRedundancyRedundant,
+					Status:              StatusOK,
+					ChassisID:           1,
+					Present:             FanPresencePresent,
+					HotPluggable:        FanHotPlugHotPluggable,
+					Speed:               FanSpeedNormal,
+					SpeedPercent:        -1,
+					RedundancyPartnerID: 4,
+					Location:            "",
+				},
+				{
+					ID:                  4,
+					Locale:              FanLocaleSystem,
+					Redundancy:          FanRedundancyRedundant,
+					Status:              StatusOK,
+					ChassisID:           1,
+					Present:             FanPresencePresent,
+					HotPluggable:        FanHotPlugHotPluggable,
+					Speed:               FanSpeedNormal,
+					SpeedPercent:        -1,
+					RedundancyPartnerID: 5,
+					Location:            "",
+				},
+				{
+					ID:                  5,
+					Locale:              FanLocaleSystem,
+					Redundancy:          FanRedundancyRedundant,
+					Status:              StatusOK,
+					ChassisID:           1,
+					Present:             FanPresencePresent,
+					HotPluggable:        FanHotPlugHotPluggable,
+					Speed:               FanSpeedNormal,
+					SpeedPercent:        -1,
+					RedundancyPartnerID: 6,
+					Location:            "",
+				},
+				{
+					ID:                  6,
+					Locale:              FanLocaleSystem,
+					Redundancy:          FanRedundancyRedundant,
+					Status:              StatusOK,
+					ChassisID:           1,
+					Present:             FanPresencePresent,
+					HotPluggable:        FanHotPlugHotPluggable,
+					Speed:               FanSpeedNormal,
+					SpeedPercent:        -1,
+					RedundancyPartnerID: 1,
+					Location:            "",
 				},
 			},
 		},
@@ -773,6 +1016,13 @@ func TestMIB_PowerSupplies(t *testing.T) {
 					Model:                 "512327-B21",
 					PowerRatingWatts:      750,
 					PowerConsumptionWatts: 105,
+					FirmwareRev:           "",
+					MainVoltage:           0,
+					Present:               true,
+					Redundant:             true,
+					HotPluggable:          true,
+					SparePartNo:           "512327-B21",
+					Location:              "",
 				},
 				{
 					ChassisNo:             0,
@@ -783,6 +1033,13 @@ func TestMIB_PowerSupplies(t *testing.T) {
 					Model:                 "512327-B21",
 					PowerRatingWatts:      750,
 					PowerConsumptionWatts: 35,
+					FirmwareRev:           "",
+					MainVoltage:           0,
+					Present:               true,
+					Redundant:             true,
+					HotPluggable:          true,
+					SparePartNo:           "512327-B21",
+					Location:              "",
 				},
 			},
 		},
@@ -799,6 +1056,13 @@ func TestMIB_PowerSupplies(t *testing.T) {
 					Model:                 "656363-B21",
 					PowerRatingWatts:      750,
 					PowerConsumptionWatts: 50,
+					FirmwareRev:           "",
+					MainVoltage:           0,
+					Present:               true,
+					Redundant:             true,
+					HotPluggable:          true,
+					SparePartNo:           "656363-B21",
+					Location:              "",
 				},
 				{
 					ChassisNo:             0,
@@ -809,6 +1073,13 @@ func TestMIB_PowerSupplies(t *testing.T) {
 					Model:                 "656363-B21",
 					PowerRatingWatts:      750,
 					PowerConsumptionWatts: 75,
+					FirmwareRev:           "",
+					MainVoltage:           0,
+					Present:               true,
+					Redundant:             true,
+					HotPluggable:          true,
+					SparePartNo:           "656363-B21",
+					Location:              "",
 				},
 			},
 		},
@@ -867,6 +1138,14 @@ func TestMIB_Processors(t *testing.T) {
 					VirtualCores:        12,
 					Status:              ProcessorStatusOK,
 					PowerStatus:         ProcessorPowerStatusUnknown,
+					Socket:              1,
+					Stepping:            2,
+					Family:              "Intel Xeon",
+					Caches: []ProcessorCache{
+						{Level: 1, SizeKB: 192, Status: ProcessorStatusOK},
+						{Level: 2, SizeKB: 1536, Status: ProcessorStatusOK},
+						{Level: 3, SizeKB: 12288, Status: ProcessorStatusOK},
+					},
 				},
 				{
 					ID:                  1,
@@ -877,6 +1156,14 @@ func TestMIB_Processors(t *testing.T) {
 					VirtualCores:        12,
 					Status:              ProcessorStatusOK,
 					PowerStatus:         ProcessorPowerStatusUnknown,
+					Socket:              2,
+					Stepping:            2,
+					Family:              "Intel Xeon",
+					Caches: []ProcessorCache{
+						{Level: 1, SizeKB: 192, Status: ProcessorStatusOK},
+						{Level: 2, SizeKB: 1536, Status: ProcessorStatusOK},
+						{Level: 3, SizeKB: 12288, Status: ProcessorStatusOK},
+					},
 				},
 			},
 		},
@@ -893,6 +1180,14 @@ func TestMIB_Processors(t *testing.T) {
 					VirtualCores:        16,
 					Status:              ProcessorStatusOK,
 					PowerStatus:         ProcessorPowerStatusUnknown,
+					Socket:              1,
+					Stepping:            7,
+					Family:              "Intel Xeon",
+					Caches: []ProcessorCache{
+						{Level: 1, SizeKB: 256, Status: ProcessorStatusOK},
+						{Level: 2, SizeKB: 2048, Status: ProcessorStatusOK},
+						{Level: 3, SizeKB: 20480, Status: ProcessorStatusOK},
+					},
 				},
 				{
 					ID:                  1,
@@ -903,6 +1198,14 @@ func TestMIB_Processors(t *testing.T) {
 					VirtualCores:        16,
 					Status:              ProcessorStatusOK,
 					PowerStatus:         ProcessorPowerStatusUnknown,
+					Socket:              2,
+					Stepping:            7,
+					Family:              "Intel Xeon",
+					Caches: []ProcessorCache{
+						{Level: 1, SizeKB: 256, Status: ProcessorStatusOK},
+						{Level: 2, SizeKB: 2048, Status: ProcessorStatusOK},
+						{Level: 3, SizeKB: 20480, Status: ProcessorStatusOK},
+					},
 				},
 			},
 		},
@@ -987,6 +1290,7 @@ func TestMIB_TemperatureSensors(t *testing.T) {
 					Threshold:             41,
 					ThresholdType:         TemperatureSensorThresholdTypeCaution,
 					Status:                StatusOK,
+					Location:              "",
 				},
 				{
 					ID:                    2,
@@ -995,6 +1299,7 @@ func TestMIB_TemperatureSensors(t *testing.T) {
 					Threshold:             82,
 					ThresholdType:         TemperatureSensorThresholdTypeCaution,
 					Status:                StatusOK,
+					Location:              "",
 				},
 				{
 					ID:                    3,
@@ -1003,6 +1308,7 @@ func TestMIB_TemperatureSensors(t *testing.T) {
 					Threshold:             82,
 					ThresholdType:         TemperatureSensorThresholdTypeCaution,
 					Status:                StatusOK,
+					Location:              "",
 				},
 				{
 					ID:                    4,
@@ -1011,6 +1317,7 @@ func TestMIB_TemperatureSensors(t *testing.T) {
 					Threshold:             87,
 					ThresholdType:         TemperatureSensorThresholdTypeCaution,
 					Status:                StatusOK,
+					Location:              "",
 				},
 				{
 					ID:                    5,
@@ -1019,6 +1326,7 @@ func TestMIB_TemperatureSensors(t *testing.T) {
 					Threshold:             87,
 					ThresholdType:         TemperatureSensorThresholdTypeCaution,
 					Status:                StatusOK,
+					Location:              "",
 				},
 				{
 					ID:                    6,
@@ -1027,6 +1335,7 @@ func TestMIB_TemperatureSensors(t *testing.T) {
 					Threshold:             87,
 					ThresholdType:         TemperatureSensorThresholdTypeCaution,
 					Status:                StatusOK,
+					Location:              "",
 				},
 				{
 					ID:                    7,
@@ -1035,6 +1344,7 @@ func TestMIB_TemperatureSensors(t *testing.T) {
 					Threshold:             87,
 					ThresholdType:         TemperatureSensorThresholdTypeCaution,
 					Status:                StatusOK,
+					Location:              "",
 				},
 				{
 					ID:                    8,
@@ -1043,6 +1353,7 @@ func TestMIB_TemperatureSensors(t *testing.T) {
 					Threshold:             90,
 					ThresholdType:         TemperatureSensorThresholdTypeCaution,
 					Status:                StatusOK,
+					Location:              "",
 				},
 				{
 					ID:                    9,
@@ -1051,6 +1362,7 @@ func TestMIB_TemperatureSensors(t *testing.T) {
 					Threshold:             65,
 					ThresholdType:         TemperatureSensorThresholdTypeCaution,
 					Status:                StatusOK,
+					Location:              "",
 				},
 				{
 					ID:                    10,
@@ -1059,6 +1371,7 @@ func TestMIB_TemperatureSensors(t *testing.T) {
 					Threshold:             90,
 					ThresholdType:         TemperatureSensorThresholdTypeCaution,
 					Status:                StatusOK,
+					Location:              "",
 				},
 				{
 					ID:                    11,
@@ -1067,6 +1380,7 @@ func TestMIB_TemperatureSensors(t *testing.T) {
 					Threshold:             70,
 					ThresholdType:         TemperatureSensorThresholdTypeCaution,
 					Status:                StatusOK,
+					Location:              "",
 				},
 				{
 					ID:                    12,
@@ -1075,6 +1389,7 @@ func TestMIB_TemperatureSensors(t *testing.T) {
 					Threshold:             90,
 					ThresholdType:         TemperatureSensorThresholdTypeCaution,
 					Status:                StatusOK,
+					Location:              "",
 				},
 				{
 					ID:                    19,
@@ -1083,6 +1398,7 @@ func TestMIB_TemperatureSensors(t *testing.T) {
 					Threshold:             70,
 					ThresholdType:         TemperatureSensorThresholdTypeCaution,
 					Status:                StatusOK,
+					Location:              "",
 				},
 				{
 					ID:                    20,
@@ -1091,6 +1407,7 @@ func TestMIB_TemperatureSensors(t *testing.T) {
 					Threshold:             70,
 					ThresholdType:         TemperatureSensorThresholdTypeCaution,
 					Status:                StatusOK,
+					Location:              "",
 				},
 				{
 					ID:                    21,
@@ -1099,6 +1416,7 @@ func TestMIB_TemperatureSensors(t *testing.T) {
 					Threshold:             80,
 					ThresholdType:         TemperatureSensorThresholdTypeCaution,
 					Status:                StatusOK,
+					Location:              "",
 				},
 				{
 					ID:                    22,
@@ -1107,6 +1425,7 @@ func TestMIB_TemperatureSensors(t *testing.T) {
 					Threshold:             80,
 					ThresholdType:         TemperatureSensorThresholdTypeCaution,
 					Status:                StatusOK,
+					Location:              "",
 				},
 				{
 					ID:                    23,
@@ -1115,6 +1434,7 @@ func TestMIB_TemperatureSensors(t *testing.T) {
 					Threshold:             77,
 					ThresholdType:         TemperatureSensorThresholdTypeCaution,
 					Status:                StatusOK,
+					Location:              "",
 				},
 				{
 					ID:                    24,
@@ -1123,6 +1443,7 @@ func TestMIB_TemperatureSensors(t *testing.T) {
 					Threshold:             70,
 					ThresholdType:         TemperatureSensorThresholdTypeCaution,
 					Status:                StatusOK,
+					Location:              "",
 				},
 				{
 					ID:                    25,
@@ -1131,6 +1452,7 @@ func TestMIB_TemperatureSensors(t *testing.T) {
 					Threshold:             70,
 					ThresholdType:         TemperatureSensorThresholdTypeCaution,
 					Status:                StatusOK,
+					Location:              "",
 				},
 				{
 					ID:                    26,
@@ -1139,6 +1461,7 @@ func TestMIB_TemperatureSensors(t *testing.T) {
 					Threshold:             70,
 					ThresholdType:         TemperatureSensorThresholdTypeCaution,
 					Status:                StatusOK,
+					Location:              "",
 				},
 				{
 					ID:                    29,
@@ -1147,6 +1470,7 @@ func TestMIB_TemperatureSensors(t *testing.T) {
 					Threshold:             60,
 					ThresholdType:         TemperatureSensorThresholdTypeCaution,
 					Status:                StatusOK,
+					Location:              "",
 				},
 				{
 					ID:                    30,
@@ -1155,6 +1479,7 @@ func TestMIB_TemperatureSensors(t *testing.T) {
 					Threshold:             110,
 					ThresholdType:         TemperatureSensorThresholdTypeCaution,
 					Status:                StatusOK,
+					Location:              "",
 				},
 			},
 		},
@@ -1421,3 +1746,983 @@ func TestMIB_PowerSupplyStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestMIB_NetworkAdapters(t *testing.T) {
+	idleAdapter := func(id, ifIndex int, macAddress string) NetworkAdapter {
+		return NetworkAdapter{
+			ID:          id,
+			IfIndex:     ifIndex,
+			Name:        "HP NC382i DP Multifunction Gigabit Server Adapter",
+			MACAddress:  macAddress,
+			SpeedMbps:   0,
+			DuplexState: DuplexStateOther,
+			Condition:   StatusOther,
+			Status:      NetworkAdapterStatusOther,
+			Statistics:  NetworkAdapterStatistics{Valid: true},
+		}
+	}
+	tests := []struct {
+		Name       string
+		Expected   []NetworkAdapter
+		Generation int
+	}{
+		{
+			Name:       "ProLiant DL380 Generation 7 Network Adapters",
+			Generation: 7,
+			Expected: []NetworkAdapter{
+				idleAdapter(1, 5, "44:1e:a1:3a:7f:9c"),
+				idleAdapter(2, 4, "44:1e:a1:3a:7f:9a"),
+				idleAdapter(3, 3, "44:1e:a1:3a:7f:98"),
+				{
+					ID:          4,
+					IfIndex:     2,
+					Name:        "HP NC382i DP Multifunction Gigabit Server Adapter",
+					MACAddress:  "44:1e:a1:3a:7f:96",
+					SpeedMbps:   1000,
+					DuplexState: DuplexStateFull,
+					Condition:   StatusOK,
+					Status:      NetworkAdapterStatusOK,
+					Statistics: NetworkAdapterStatistics{
+						Valid:         true,
+						GoodTransmits: 22591322,
+						GoodReceives:  743688238,
+						InOctets:      4259622248,
+						OutOctets:     3654590398,
+					},
+				},
+			},
+		},
+		{
+			Name:       "ProLiant DL380 Generation 8 Network Adapters",
+			Generation: 8,
+			Expected:   []NetworkAdapter{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			mib := newTestingMIB(t, test.Generation)
+			adapters, err := mib.NetworkAdapters()
+			require.NoError(t, err, "failed to retrieve network adapters from the MIB")
+			assert.Equal(t, test.Expected, adapters)
+		})
+	}
+}
+
+func TestMIB_ProcessorUtilization(t *testing.T) {
+	tests := []struct {
+		Name       string
+		Expected   []ProcessorUtilization
+		Generation int
+	}{
+		{
+			Name:       "ProLiant DL380 Generation 7 Processor Utilization",
+			Generation: 7,
+			Expected: []ProcessorUtilization{
+				{ID: 0, Name: "Total", InterruptsPerSec: 487, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: -1, FiveMinutePercent: -1, ThirtyMinutePercent: -1, OneHourPercent: -1},
+				{ID: 1, Name: "Processor 1", InterruptsPerSec: 1, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 2, Name: "Processor 2", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 3, Name: "Processor 3", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 4, Name: "Processor 4", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 5, Name: "Processor 5", InterruptsPerSec: 12, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 6, Name: "Processor 6", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 7, Name: "Processor 7", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 8, Name: "Processor 8", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 9, Name: "Processor 9", InterruptsPerSec: 19, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 10, Name: "Processor 10", InterruptsPerSec: 7, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 11, Name: "Processor 11", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 12, Name: "Processor 12", InterruptsPerSec: 7, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 13, Name: "Processor 13", InterruptsPerSec: 5, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 14, Name: "Processor 14", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 15, Name: "Processor 15", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 16, Name: "Processor 16", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 17, Name: "Processor 17", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 18, Name: "Processor 18", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 19, Name: "Processor 19", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 20, Name: "Processor 20", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 21, Name: "Processor 21", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 22, Name: "Processor 22", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 23, Name: "Processor 23", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 24, Name: "Processor 24", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+			},
+		},
+		{
+			Name:       "ProLiant DL380 Generation 8 Processor Utilization",
+			Generation: 8,
+			Expected: []ProcessorUtilization{
+				{ID: 0, Name: "Total", InterruptsPerSec: 2526, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: -1, FiveMinutePercent: -1, ThirtyMinutePercent: -1, OneHourPercent: -1},
+				{ID: 1, Name: "Processor 1", InterruptsPerSec: 21, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 1, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 2, Name: "Processor 2", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 3, Name: "Processor 3", InterruptsPerSec: 3, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 1, FiveMinutePercent: 1, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 4, Name: "Processor 4", InterruptsPerSec: 10, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 5, Name: "Processor 5", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 6, Name: "Processor 6", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 7, Name: "Processor 7", InterruptsPerSec: 27, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 1, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 8, Name: "Processor 8", InterruptsPerSec: 2, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 9, Name: "Processor 9", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 10, Name: "Processor 10", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 11, Name: "Processor 11", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 12, Name: "Processor 12", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 13, Name: "Processor 13", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 1, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 14, Name: "Processor 14", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 15, Name: "Processor 15", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 16, Name: "Processor 16", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 17, Name: "Processor 17", InterruptsPerSec: 1, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 18, Name: "Processor 18", InterruptsPerSec: 16, TimePercent: 1, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 1, FiveMinutePercent: 1, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 19, Name: "Processor 19", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 1, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 20, Name: "Processor 20", InterruptsPerSec: 0, TimePercent: 1, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 1, FiveMinutePercent: 1, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 21, Name: "Processor 21", InterruptsPerSec: 4, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 22, Name: "Processor 22", InterruptsPerSec: 2, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 23, Name: "Processor 23", InterruptsPerSec: 6, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 24, Name: "Processor 24", InterruptsPerSec: 2, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 25, Name: "Processor 25", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 26, Name: "Processor 26", InterruptsPerSec: 0, TimePercent: 1, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 1, FiveMinutePercent: 1, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 27, Name: "Processor 27", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 28, Name: "Processor 28", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 29, Name: "Processor 29", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 30, Name: "Processor 30", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 31, Name: "Processor 31", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+				{ID: 32, Name: "Processor 32", InterruptsPerSec: 0, TimePercent: 0, UserTimePercent: 0, PrivilegedTimePercent: 0, OneMinutePercent: 0, FiveMinutePercent: 0, ThirtyMinutePercent: 0, OneHourPercent: 0},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			mib := newTestingMIB(t, test.Generation)
+			utilization, err := mib.ProcessorUtilization()
+			require.NoError(t, err, "failed to retrieve processor utilization from the MIB")
+			assert.Equal(t, test.Expected, utilization)
+		})
+	}
+}
+
+func TestMIB_DiskIOStats(t *testing.T) {
+	tests := []struct {
+		Name       string
+		Expected   []DiskIOStats
+		Generation int
+	}{
+		{
+			Name:       "ProLiant DL380 Generation 7 Disk I/O Statistics",
+			Generation: 7,
+			Expected: []DiskIOStats{
+				{Major: 1, Minor: 0, Name: "ram0", DevicePath: "/dev/ram0", Reads: 0, ReadMerges: 0, ReadSectors: 0, ReadTimeMs: 0, Writes: 0, WriteSectors: 0, WriteTimeMs: 0},
+				{Major: 7, Minor: 0, Name: "loop0", DevicePath: "/dev/loop0", Reads: 0, ReadMerges: 0, ReadSectors: 0, ReadTimeMs: 0, Writes: 0, WriteSectors: 0, WriteTimeMs: 0},
+				{Major: 8, Minor: 0, Name: "sda", DevicePath: "/dev/sda", Reads: 141, ReadMerges: 674, ReadSectors: 6520, ReadTimeMs: 101, Writes: 4, WriteSectors: 217, WriteTimeMs: 7},
+				{Major: 8, Minor: 16, Name: "sdb", DevicePath: "/dev/sdb", Reads: 0, ReadMerges: 0, ReadSectors: 0, ReadTimeMs: 0, Writes: 0, WriteSectors: 0, WriteTimeMs: 0},
+				{Major: 8, Minor: 32, Name: "sdc", DevicePath: "/dev/sdc", Reads: 0, ReadMerges: 0, ReadSectors: 0, ReadTimeMs: 0, Writes: 0, WriteSectors: 0, WriteTimeMs: 0},
+				{Major: 11, Minor: 0, Name: "sr0", DevicePath: "/dev/sr0", Reads: 0, ReadMerges: 0, ReadSectors: 0, ReadTimeMs: 0, Writes: 0, WriteSectors: 0, WriteTimeMs: 0},
+				{Major: 253, Minor: 0, Name: "dm-0", DevicePath: "/dev/dm-0", Reads: 0, ReadMerges: 0, ReadSectors: 0, ReadTimeMs: 0, Writes: 0, WriteSectors: 0, WriteTimeMs: 0},
+			},
+		},
+		{
+			Name:       "ProLiant DL380 Generation 8 Disk I/O Statistics",
+			Generation: 8,
+			Expected: []DiskIOStats{
+				{Major: 8, Minor: 0, Name: "sda", DevicePath: "/dev/sda", Reads: 692, ReadMerges: 20, ReadSectors: 15472, ReadTimeMs: 21, Writes: 23, WriteSectors: 515, WriteTimeMs: 0},
+				{Major: 8, Minor: 16, Name: "sdb", DevicePath: "/dev/sdb", Reads: 0, ReadMerges: 0, ReadSectors: 0, ReadTimeMs: 0, Writes: 0, WriteSectors: 0, WriteTimeMs: 0},
+				{Major: 8, Minor: 32, Name: "sdc", DevicePath: "/dev/sdc", Reads: 0, ReadMerges: 0, ReadSectors: 0, ReadTimeMs: 0, Writes: 0, WriteSectors: 0, WriteTimeMs: 0},
+				{Major: 253, Minor: 0, Name: "dm-0", DevicePath: "/dev/dm-0", Reads: 666, ReadMerges: 0, ReadSectors: 8918, ReadTimeMs: 25, Writes: 22, WriteSectors: 297, WriteTimeMs: 0},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			mib := newTestingMIB(t, test.Generation)
+			stats, err := mib.DiskIOStats()
+			require.NoError(t, err, "failed to retrieve disk I/O statistics from the MIB")
+			assert.Equal(t, test.Expected, stats)
+		})
+	}
+}
+
+func TestMIB_InterfaceStats(t *testing.T) {
+	tests := []struct {
+		Name       string
+		Expected   []InterfaceStats
+		Generation int
+	}{
+		{
+			Name:       "ProLiant DL380 Generation 7 Interface Statistics",
+			Generation: 7,
+			Expected: []InterfaceStats{
+				{ID: 0, Name: "lo", RxBytes: 262144, RxPackets: 620, RxBytesPerSec: 8729, RxPacketsPerSec: 20, TxBytes: 262144, TxPackets: 620, TxBytesPerSec: 8729, TxPacketsPerSec: 20},
+				{ID: 1, Name: "eth0", RxBytes: 27927, RxPackets: 285, RxBytesPerSec: 930, RxPacketsPerSec: 9, TxBytes: 77661, TxPackets: 1070, TxBytesPerSec: 2586, TxPacketsPerSec: 35},
+				{ID: 2, Name: "eth1", RxBytes: 0, RxPackets: 0, RxBytesPerSec: 0, RxPacketsPerSec: 0, TxBytes: 0, TxPackets: 0, TxBytesPerSec: 0, TxPacketsPerSec: 0},
+				{ID: 3, Name: "eth2", RxBytes: 0, RxPackets: 0, RxBytesPerSec: 0, RxPacketsPerSec: 0, TxBytes: 0, TxPackets: 0, TxBytesPerSec: 0, TxPacketsPerSec: 0},
+				{ID: 4, Name: "eth3", RxBytes: 0, RxPackets: 0, RxBytesPerSec: 0, RxPacketsPerSec: 0, TxBytes: 0, TxPackets: 0, TxBytesPerSec: 0, TxPacketsPerSec: 0},
+			},
+		},
+		{
+			Name:       "ProLiant DL380 Generation 8 Interface Statistics",
+			Generation: 8,
+			Expected: []InterfaceStats{
+				{ID: 0, Name: "eth0", RxBytes: 933712, RxPackets: 1036, RxBytesPerSec: 31110, RxPacketsPerSec: 34, TxBytes: 147022, TxPackets: 1346, TxBytesPerSec: 4898, TxPacketsPerSec: 44},
+				{ID: 1, Name: "eth1", RxBytes: 0, RxPackets: 0, RxBytesPerSec: 0, RxPacketsPerSec: 0, TxBytes: 0, TxPackets: 0, TxBytesPerSec: 0, TxPacketsPerSec: 0},
+				{ID: 2, Name: "eth2", RxBytes: 0, RxPackets: 0, RxBytesPerSec: 0, RxPacketsPerSec: 0, TxBytes: 0, TxPackets: 0, TxBytesPerSec: 0, TxPacketsPerSec: 0},
+				{ID: 3, Name: "eth3", RxBytes: 0, RxPackets: 0, RxBytesPerSec: 0, RxPacketsPerSec: 0, TxBytes: 0, TxPackets: 0, TxBytesPerSec: 0, TxPacketsPerSec: 0},
+				{ID: 4, Name: "lo", RxBytes: 610607, RxPackets: 1507, RxBytesPerSec: 20344, RxPacketsPerSec: 50, TxBytes: 610607, TxPackets: 1507, TxBytesPerSec: 20344, TxPacketsPerSec: 50},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			mib := newTestingMIB(t, test.Generation)
+			stats, err := mib.InterfaceStats()
+			require.NoError(t, err, "failed to retrieve interface statistics from the MIB")
+			assert.Equal(t, test.Expected, stats)
+		})
+	}
+}
+
+func TestMIB_FileSystems(t *testing.T) {
+	tests := []struct {
+		Name       string
+		Expected   []FileSystem
+		Generation int
+	}{
+		{
+			Name:       "ProLiant DL380 Generation 7 File Systems",
+			Generation: 7,
+			Expected: []FileSystem{
+				{ID: 0, Description: "/dev/mapper/vg-root on /", Device: "/dev/mapper/vg-root", MountPoint: "/", TotalMB: 50268, UsedMB: 8684, FreeMB: 41584, PercentUsed: 18, Condition: StatusUnknown},
+				{ID: 1, Description: "proc on /proc", Device: "proc", MountPoint: "/proc", TotalMB: 0, UsedMB: 0, FreeMB: 0, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 2, Description: "sysfs on /sys", Device: "sysfs", MountPoint: "/sys", TotalMB: 0, UsedMB: 0, FreeMB: 0, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 3, Description: "devpts on /dev/pts", Device: "devpts", MountPoint: "/dev/pts", TotalMB: 0, UsedMB: 0, FreeMB: 0, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 4, Description: "tmpfs on /dev/shm", Device: "tmpfs", MountPoint: "/dev/shm", TotalMB: 96842, UsedMB: 0, FreeMB: 96842, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 5, Description: "/dev/sda1 on /boot", Device: "/dev/sda1", MountPoint: "/boot", TotalMB: 476, UsedMB: 35, FreeMB: 441, PercentUsed: 8, Condition: StatusUnknown},
+				{ID: 6, Description: "/dev/mapper/vg-opt on /opt", Device: "/dev/mapper/vg-opt", MountPoint: "/opt", TotalMB: 823321, UsedMB: 128, FreeMB: 823193, PercentUsed: 1, Condition: StatusUnknown},
+				{ID: 7, Description: "/dev/mapper/vg-audit on /var/log/audit", Device: "/dev/mapper/vg-audit", MountPoint: "/var/log/audit", TotalMB: 32125, UsedMB: 73, FreeMB: 32052, PercentUsed: 1, Condition: StatusUnknown},
+				{ID: 8, Description: "none on /proc/sys/fs/binfmt_misc", Device: "none", MountPoint: "/proc/sys/fs/binfmt_misc", TotalMB: 0, UsedMB: 0, FreeMB: 0, PercentUsed: 0, Condition: StatusUnknown},
+			},
+		},
+		{
+			Name:       "ProLiant DL380 Generation 8 File Systems",
+			Generation: 8,
+			Expected: []FileSystem{
+				{ID: 0, Description: "/dev/mapper/vg-root on /", Device: "/dev/mapper/vg-root", MountPoint: "/", TotalMB: 51175, UsedMB: 1324, FreeMB: 49851, PercentUsed: 3, Condition: StatusUnknown},
+				{ID: 1, Description: "sysfs on /sys", Device: "sysfs", MountPoint: "/sys", TotalMB: 0, UsedMB: 0, FreeMB: 0, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 2, Description: "proc on /proc", Device: "proc", MountPoint: "/proc", TotalMB: 0, UsedMB: 0, FreeMB: 0, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 3, Description: "devtmpfs on /dev", Device: "devtmpfs", MountPoint: "/dev", TotalMB: 128860, UsedMB: 0, FreeMB: 128860, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 4, Description: "securityfs on /sys/kernel/security", Device: "securityfs", MountPoint: "/sys/kernel/security", TotalMB: 0, UsedMB: 0, FreeMB: 0, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 5, Description: "tmpfs on /dev/shm", Device: "tmpfs", MountPoint: "/dev/shm", TotalMB: 128874, UsedMB: 0, FreeMB: 128874, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 6, Description: "devpts on /dev/pts", Device: "devpts", MountPoint: "/dev/pts", TotalMB: 0, UsedMB: 0, FreeMB: 0, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 7, Description: "tmpfs on /run", Device: "tmpfs", MountPoint: "/run", TotalMB: 128874, UsedMB: 18, FreeMB: 128856, PercentUsed: 1, Condition: StatusUnknown},
+				{ID: 8, Description: "tmpfs on /sys/fs/cgroup", Device: "tmpfs", MountPoint: "/sys/fs/cgroup", TotalMB: 128874, UsedMB: 0, FreeMB: 128874, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 9, Description: "cgroup on /sys/fs/cgroup/systemd", Device: "cgroup", MountPoint: "/sys/fs/cgroup/systemd", TotalMB: 0, UsedMB: 0, FreeMB: 0, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 10, Description: "pstore on /sys/fs/pstore", Device: "pstore", MountPoint: "/sys/fs/pstore", TotalMB: 0, UsedMB: 0, FreeMB: 0, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 11, Description: "cgroup on /sys/fs/cgroup/net_cls,net_prio", Device: "cgroup", MountPoint: "/sys/fs/cgroup/net_cls,net_prio", TotalMB: 0, UsedMB: 0, FreeMB: 0, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 12, Description: "cgroup on /sys/fs/cgroup/freezer", Device: "cgroup", MountPoint: "/sys/fs/cgroup/freezer", TotalMB: 0, UsedMB: 0, FreeMB: 0, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 13, Description: "cgroup on /sys/fs/cgroup/pids", Device: "cgroup", MountPoint: "/sys/fs/cgroup/pids", TotalMB: 0, UsedMB: 0, FreeMB: 0, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 14, Description: "cgroup on /sys/fs/cgroup/perf_event", Device: "cgroup", MountPoint: "/sys/fs/cgroup/perf_event", TotalMB: 0, UsedMB: 0, FreeMB: 0, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 15, Description: "cgroup on /sys/fs/cgroup/hugetlb", Device: "cgroup", MountPoint: "/sys/fs/cgroup/hugetlb", TotalMB: 0, UsedMB: 0, FreeMB: 0, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 16, Description: "cgroup on /sys/fs/cgroup/blkio", Device: "cgroup", MountPoint: "/sys/fs/cgroup/blkio", TotalMB: 0, UsedMB: 0, FreeMB: 0, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 17, Description: "cgroup on /sys/fs/cgroup/cpu,cpuacct", Device: "cgroup", MountPoint: "/sys/fs/cgroup/cpu,cpuacct", TotalMB: 0, UsedMB: 0, FreeMB: 0, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 18, Description: "cgroup on /sys/fs/cgroup/devices", Device: "cgroup", MountPoint: "/sys/fs/cgroup/devices", TotalMB: 0, UsedMB: 0, FreeMB: 0, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 19, Description: "cgroup on /sys/fs/cgroup/cpuset", Device: "cgroup", MountPoint: "/sys/fs/cgroup/cpuset", TotalMB: 0, UsedMB: 0, FreeMB: 0, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 20, Description: "cgroup on /sys/fs/cgroup/memory", Device: "cgroup", MountPoint: "/sys/fs/cgroup/memory", TotalMB: 0, UsedMB: 0, FreeMB: 0, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 21, Description: "configfs on /sys/kernel/config", Device: "configfs", MountPoint: "/sys/kernel/config", TotalMB: 0, UsedMB: 0, FreeMB: 0, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 22, Description: "binfmt_misc on /proc/sys/fs/binfmt_misc", Device: "binfmt_misc", MountPoint: "/proc/sys/fs/binfmt_misc", TotalMB: 0, UsedMB: 0, FreeMB: 0, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 23, Description: "debugfs on /sys/kernel/debug", Device: "debugfs", MountPoint: "/sys/kernel/debug", TotalMB: 0, UsedMB: 0, FreeMB: 0, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 24, Description: "mqueue on /dev/mqueue", Device: "mqueue", MountPoint: "/dev/mqueue", TotalMB: 0, UsedMB: 0, FreeMB: 0, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 25, Description: "hugetlbfs on /dev/hugepages", Device: "hugetlbfs", MountPoint: "/dev/hugepages", TotalMB: 0, UsedMB: 0, FreeMB: 0, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 26, Description: "/dev/sda1 on /boot", Device: "/dev/sda1", MountPoint: "/boot", TotalMB: 493, UsedMB: 148, FreeMB: 345, PercentUsed: 30, Condition: StatusUnknown},
+				{ID: 27, Description: "/dev/mapper/vg-audit on /var/log/audit", Device: "/dev/mapper/vg-audit", MountPoint: "/var/log/audit", TotalMB: 32752, UsedMB: 34, FreeMB: 32718, PercentUsed: 1, Condition: StatusUnknown},
+				{ID: 28, Description: "/dev/mapper/vg-opt on /opt", Device: "/dev/mapper/vg-opt", MountPoint: "/opt", TotalMB: 454829, UsedMB: 2549, FreeMB: 452280, PercentUsed: 1, Condition: StatusUnknown},
+				{ID: 29, Description: "sunrpc on /var/lib/nfs/rpc_pipefs", Device: "sunrpc", MountPoint: "/var/lib/nfs/rpc_pipefs", TotalMB: 0, UsedMB: 0, FreeMB: 0, PercentUsed: 0, Condition: StatusUnknown},
+				{ID: 30, Description: "tmpfs on /run/user/0", Device: "tmpfs", MountPoint: "/run/user/0", TotalMB: 25774, UsedMB: 0, FreeMB: 25774, PercentUsed: 0, Condition: StatusUnknown},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			mib := newTestingMIB(t, test.Generation)
+			fileSystems, err := mib.FileSystems()
+			require.NoError(t, err, "failed to retrieve file systems from the MIB")
+			assert.Equal(t, test.Expected, fileSystems)
+		})
+	}
+}
+
+func TestMIB_Firmware(t *testing.T) {
+	tests := []struct {
+		Name       string
+		Expected   []Firmware
+		Generation int
+	}{
+		{
+			Name:       "ProLiant DL380 Generation 7 Firmware",
+			Generation: 7,
+			Expected: []Firmware{
+				{ID: 0, Name: "HP ProLiant System ROM", Version: "P67 05.05.2011", Date: time.Date(2011, 5, 5, 0, 0, 0, 0, time.UTC), Location: "System Board", Category: FirmwareCategorySystem},
+				{ID: 1, Name: "Integrated Lights Out Firmware", Version: "1.26", Date: time.Time{}, Location: "System Board", Category: FirmwareCategoryRIB},
+				{ID: 2, Name: "HP P410i", Version: "5.14", Date: time.Time{}, Location: "scsi1(03:00:00)", Category: FirmwareCategoryStorage},
+				{ID: 3, Name: "HP LOGICAL VOLUME", Version: "5.14", Date: time.Time{}, Location: "scsi1(00:00:00)", Category: FirmwareCategoryStorage},
+				{ID: 4, Name: "HP LOGICAL VOLUME", Version: "5.14", Date: time.Time{}, Location: "scsi1(00:00:01)", Category: FirmwareCategoryStorage},
+				{ID: 5, Name: "SanDisk Extreme", Version: "0001", Date: time.Time{}, Location: "scsi0(00:00:00)", Category: FirmwareCategoryStorage},
+				{ID: 6, Name: "HP DV-W28S-W", Version: "G.W3", Date: time.Time{}, Location: "scsi2(00:00:00)", Category: FirmwareCategoryStorage},
+				{ID: 7, Name: "PowerPIC Firmware", Version: "1.6", Date: time.Time{}, Location: "System Board", Category: FirmwareCategoryOther},
+			},
+		},
+		{
+			Name:       "ProLiant DL380 Generation 8 Firmware",
+			Generation: 8,
+			Expected: []Firmware{
+				{ID: 0, Name: "HP ProLiant System ROM", Version: "P70 03.01.2013", Date: time.Date(2013, 3, 1, 0, 0, 0, 0, time.UTC), Location: "System Board", Category: FirmwareCategorySystem},
+				{ID: 1, Name: "Integrated Lights Out Firmware", Version: "1.22", Date: time.Time{}, Location: "System Board", Category: FirmwareCategoryRIB},
+				{ID: 2, Name: "HP P420i", Version: "3.54", Date: time.Time{}, Location: "scsi0(00:00:00)", Category: FirmwareCategoryStorage},
+				{ID: 3, Name: "HP LOGICAL VOLUME", Version: "3.54", Date: time.Time{}, Location: "scsi0(01:00:00)", Category: FirmwareCategoryStorage},
+				{ID: 4, Name: "HP LOGICAL VOLUME", Version: "3.54", Date: time.Time{}, Location: "scsi0(01:00:01)", Category: FirmwareCategoryStorage},
+				{ID: 5, Name: "SanDisk Extreme", Version: "0001", Date: time.Time{}, Location: "scsi3(00:00:00)", Category: FirmwareCategoryStorage},
+				{ID: 6, Name: "PowerPIC Firmware", Version: "3.1", Date: time.Time{}, Location: "System Board", Category: FirmwareCategoryOther},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			mib := newTestingMIB(t, test.Generation)
+			firmware, err := mib.Firmware()
+			require.NoError(t, err, "failed to retrieve firmware from the MIB")
+			assert.Equal(t, test.Expected, firmware)
+		})
+	}
+}
+
+func TestMIB_Software(t *testing.T) {
+	tests := []struct {
+		Name       string
+		Expected   []Software
+		Generation int
+	}{
+		{
+			Name:       "ProLiant DL380 Generation 7 Software",
+			Generation: 7,
+			Expected: []Software{
+				{ID: 0, Name: "CONFIG", Description: "HP System Configuration Utility", Type: SoftwareTypeSysUtil, Status: SoftwareStatusOther, Version: "0.0"},
+				{ID: 1, Name: "SYSTEMROMPAQ", Description: "HP System ROMPAQ Utility", Type: SoftwareTypeSysUtil, Status: SoftwareStatusOther, Version: "0.0"},
+				{ID: 2, Name: "DIAGS", Description: "HP Diagnostics Utility", Type: SoftwareTypeSysUtil, Status: SoftwareStatusOther, Version: "0.0"},
+				{ID: 3, Name: "DISKDR", Description: "HP Drive Array Diagnostic Utility", Type: SoftwareTypeSysUtil, Status: SoftwareStatusOther, Version: "0.0"},
+				{ID: 4, Name: "net-snmp_x86_64", Description: "A collection of SNMP protocol tools and libraries", Type: SoftwareTypeApplication, Status: SoftwareStatusLoaded, Version: "5.5-60.el6"},
+				{ID: 5, Name: "net-snmp-libs_x86_64", Description: "The NET-SNMP runtime libraries", Type: SoftwareTypeApplication, Status: SoftwareStatusLoaded, Version: "5.5-60.el6"},
+				{ID: 6, Name: "hp-snmp-agents_x86_64", Description: "Insight Management Agents(SNMP) for HPE ProLiant Systems", Type: SoftwareTypeAgent, Status: SoftwareStatusLoaded, Version: "10.50-2926.39.rhel6"},
+				{ID: 7, Name: "hponcfg_x86_64", Description: "Hponcfg - HP Lights-Out Online Configuration Utility", Type: SoftwareTypeApplication, Status: SoftwareStatusLoaded, Version: "4.6.0-0"},
+				{ID: 8, Name: "hp-health_x86_64", Description: "HPE System Health Application and Command Line Utilities", Type: SoftwareTypeApplication, Status: SoftwareStatusLoaded, Version: "10.50-1826.38.rhel6"},
+				{ID: 9, Name: "net-snmp-utils_x86_64", Description: "Network management utilities using SNMP, from the NET-SNMP project", Type: SoftwareTypeApplication, Status: SoftwareStatusLoaded, Version: "5.5-60.el6"},
+			},
+		},
+		{
+			Name:       "ProLiant DL380 Generation 8 Software",
+			Generation: 8,
+			Expected: []Software{
+				{ID: 0, Name: "CONFIG", Description: "HP System Configuration Utility", Type: SoftwareTypeSysUtil, Status: SoftwareStatusOther, Version: "0.0"},
+				{ID: 1, Name: "SYSTEMROMPAQ", Description: "HP System ROMPAQ Utility", Type: SoftwareTypeSysUtil, Status: SoftwareStatusOther, Version: "0.0"},
+				{ID: 2, Name: "DIAGS", Description: "HP Diagnostics Utility", Type: SoftwareTypeSysUtil, Status: SoftwareStatusOther, Version: "0.0"},
+				{ID: 3, Name: "DISKDR", Description: "HP Drive Array Diagnostic Utility", Type: SoftwareTypeSysUtil, Status: SoftwareStatusOther, Version: "0.0"},
+				{ID: 4, Name: "hponcfg_x86_64", Description: "Hponcfg - HP Lights-Out Online Configuration Utility", Type: SoftwareTypeApplication, Status: SoftwareStatusLoaded, Version: "5.3.0-0"},
+				{ID: 5, Name: "net-snmp-libs_x86_64", Description: "The NET-SNMP runtime client libraries", Type: SoftwareTypeApplication, Status: SoftwareStatusLoaded, Version: "5.7.2-33.el7_5.2"},
+				{ID: 6, Name: "net-snmp-agent-libs_x86_64", Description: "The NET-SNMP runtime agent libraries", Type: SoftwareTypeApplication, Status: SoftwareStatusLoaded, Version: "5.7.2-33.el7_5.2"},
+				{ID: 7, Name: "hp-health_x86_64", Description: "HPE System Health Application and Command Line Utilities", Type: SoftwareTypeApplication, Status: SoftwareStatusLoaded, Version: "10.80-1855.21.rhel7"},
+				{ID: 8, Name: "net-snmp_x86_64", Description: "A collection of SNMP protocol tools and libraries", Type: SoftwareTypeApplication, Status: SoftwareStatusLoaded, Version: "5.7.2-33.el7_5.2"},
+				{ID: 9, Name: "hp-snmp-agents_x86_64", Description: "Insight Management Agents(SNMP) for HPE ProLiant Systems", Type: SoftwareTypeAgent, Status: SoftwareStatusLoaded, Version: "10.80-2965.21.rhel7"},
+				{ID: 10, Name: "net-snmp-utils_x86_64", Description: "Network management utilities using SNMP, from the NET-SNMP project", Type: SoftwareTypeApplication, Status: SoftwareStatusLoaded, Version: "5.7.2-33.el7_5.2"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			mib := newTestingMIB(t, test.Generation)
+			software, err := mib.Software()
+			require.NoError(t, err, "failed to retrieve software from the MIB")
+			assert.Equal(t, test.Expected, software)
+		})
+	}
+}
+
+func TestMIB_HostOS(t *testing.T) {
+	tests := []struct {
+		Name       string
+		Expected   HostOS
+		Generation int
+	}{
+		{
+			Name:       "ProLiant DL380 Generation 7 Host OS",
+			Generation: 7,
+			Expected: HostOS{
+				Name:        "Red Hat Enterprise Linux",
+				Version:     "6.9",
+				Description: "Red Hat Enterprise Linux Server release 6.9 (Santiago)",
+				HostName:    "sj-test-01.srx.int",
+			},
+		},
+		{
+			Name:       "ProLiant DL380 Generation 8 Host OS",
+			Generation: 8,
+			Expected: HostOS{
+				Name:        "Red Hat Enterprise Linux",
+				Version:     "7.5",
+				Description: "Red Hat Enterprise Linux Server release 7.5 (Maipo)",
+				HostName:    "sj-test-03.srx.int",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			mib := newTestingMIB(t, test.Generation)
+			hostOS, err := mib.HostOS()
+			require.NoError(t, err, "failed to retrieve host OS from the MIB")
+			assert.Equal(t, test.Expected, hostOS)
+		})
+	}
+}
+
+func TestMIB_SystemIdentity(t *testing.T) {
+	tests := []struct {
+		Name       string
+		Expected   SystemIdentity
+		Generation int
+	}{
+		{
+			Name:       "ProLiant DL380 Generation 7 System Identity",
+			Generation: 7,
+			Expected: SystemIdentity{
+				HostOS: HostOS{
+					Name:        "Red Hat Enterprise Linux",
+					Version:     "6.9",
+					Description: "Red Hat Enterprise Linux Server release 6.9 (Santiago)",
+					HostName:    "sj-test-01.srx.int",
+				},
+				SerialNumber: "CZ21470BB8",
+				Model:        "ProLiant DL380 G7",
+				GUID:         "583966CZ21470BB8",
+				UUID:         "39333835-3636-5A43-3231-343730424238",
+				MIBRevision:  "1.36",
+				AgentVersions: map[string]string{
+					"hp-snmp-agents_x86_64": "10.50-2926.39.rhel6",
+				},
+			},
+		},
+		{
+			Name:       "ProLiant DL380 Generation 8 System Identity",
+			Generation: 8,
+			Expected: SystemIdentity{
+				HostOS: HostOS{
+					Name:        "Red Hat Enterprise Linux",
+					Version:     "7.5",
+					Description: "Red Hat Enterprise Linux Server release 7.5 (Maipo)",
+					HostName:    "sj-test-03.srx.int",
+				},
+				SerialNumber: "USE31629DN",
+				Model:        "ProLiant DL380p Gen8",
+				GUID:         "706539USE31629DN",
+				UUID:         "35363037-3933-5355-4533-31363239444E",
+				MIBRevision:  "1.36",
+				AgentVersions: map[string]string{
+					"hp-snmp-agents_x86_64": "10.80-2965.21.rhel7",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			mib := newTestingMIB(t, test.Generation)
+			identity, err := mib.SystemIdentity()
+			require.NoError(t, err, "failed to retrieve system identity from the MIB")
+			assert.Equal(t, test.Expected, identity)
+		})
+	}
+}
+
+func TestMIB_PhysicalDriveErrors(t *testing.T) {
+	unsupported := func(cntlrIndex, index int) PhysicalDriveErrors {
+		return PhysicalDriveErrors{
+			ControllerID: cntlrIndex,
+			DriveID:      index,
+			Counters:     map[PhysicalDriveErrorCounter]int{},
+		}
+	}
+	tests := []struct {
+		Name       string
+		Expected   []PhysicalDriveErrors
+		Generation int
+	}{
+		{
+			Name:       "ProLiant DL380 Generation 7 Physical Drive Errors",
+			Generation: 7,
+			Expected: []PhysicalDriveErrors{
+				unsupported(0, 0),
+				unsupported(0, 1),
+				unsupported(0, 2),
+				unsupported(0, 3),
+			},
+		},
+		{
+			Name:       "ProLiant DL380 Generation 8 Physical Drive Errors",
+			Generation: 8,
+			Expected: []PhysicalDriveErrors{
+				unsupported(0, 8),
+				unsupported(0, 9),
+				unsupported(0, 10),
+				unsupported(0, 11),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			mib := newTestingMIB(t, test.Generation)
+			errs, err := mib.PhysicalDriveErrors()
+			require.NoError(t, err, "failed to retrieve physical drive errors from the MIB")
+			assert.Equal(t, test.Expected, errs)
+		})
+	}
+}
+
+func TestMIB_PhysicalDriveThresholds(t *testing.T) {
+	tests := []struct {
+		Name       string
+		Expected   []PhysicalDriveThreshold
+		Generation int
+	}{
+		{
+			Name:       "ProLiant DL380 Generation 7 Physical Drive Thresholds",
+			Generation: 7,
+			Expected: []PhysicalDriveThreshold{
+				{ControllerID: 0, DriveID: 0, ID: 1, Parameter: 15, Threshold: 3, Value: 3},
+				{ControllerID: 0, DriveID: 1, ID: 1, Parameter: 15, Threshold: 0, Value: 0},
+			},
+		},
+		{
+			Name:       "ProLiant DL380 Generation 8 Physical Drive Thresholds",
+			Generation: 8,
+			Expected: []PhysicalDriveThreshold{
+				{ControllerID: 0, DriveID: 8, ID: 1, Parameter: 15, Threshold: 20, Value: 20},
+				{ControllerID: 0, DriveID: 9, ID: 1, Parameter: 15, Threshold: 0, Value: 0},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			mib := newTestingMIB(t, test.Generation)
+			thresholds, err := mib.PhysicalDriveThresholds()
+			require.NoError(t, err, "failed to retrieve physical drive thresholds from the MIB")
+			assert.Equal(t, test.Expected, thresholds)
+			assert.Empty(t, ExceededThresholds(thresholds))
+		})
+	}
+}
+
+func TestMIB_StorageEnclosures(t *testing.T) {
+	tests := []struct {
+		Name       string
+		Expected   []StorageEnclosure
+		Generation int
+	}{
+		{
+			Name:       "ProLiant DL380 Generation 7 Storage Enclosures",
+			Generation: 7,
+			Expected: []StorageEnclosure{
+				{
+					ID:                 0,
+					ControllerID:       0,
+					Location:           "Port 1I Box 1",
+					ControllerLocation: "Slot 0",
+					TotalBays:          4,
+					Condition:          StatusOK,
+					FanStatus:          EnclosureComponentStatusNotPresent,
+					TemperatureStatus:  EnclosureComponentStatusNotPresent,
+					PowerSupplyStatus:  EnclosureComponentStatusNotPresent,
+				},
+				{
+					ID:                 1,
+					ControllerID:       0,
+					Location:           "Port 2I Box 1",
+					ControllerLocation: "Slot 0",
+					TotalBays:          4,
+					Condition:          StatusOK,
+					FanStatus:          EnclosureComponentStatusNotPresent,
+					TemperatureStatus:  EnclosureComponentStatusNotPresent,
+					PowerSupplyStatus:  EnclosureComponentStatusNotPresent,
+				},
+			},
+		},
+		{
+			Name:       "ProLiant DL380 Generation 8 Storage Enclosures",
+			Generation: 8,
+			Expected: []StorageEnclosure{
+				{
+					ID:                 0,
+					ControllerID:       0,
+					Name:               "Gen8 ServBP 25+2",
+					Vendor:             "HP",
+					FirmwareRev:        "2.16",
+					SerialNo:           "FY28BP4127",
+					Location:           "Port 1I Box 1",
+					ControllerLocation: "Slot 0",
+					TotalBays:          13,
+					Condition:          StatusOK,
+					FanStatus:          EnclosureComponentStatusNotPresent,
+					TemperatureStatus:  EnclosureComponentStatusNotPresent,
+					PowerSupplyStatus:  EnclosureComponentStatusNotPresent,
+				},
+				{
+					ID:                 1,
+					ControllerID:       0,
+					Name:               "Gen8 ServBP 25+2",
+					Vendor:             "HP",
+					FirmwareRev:        "2.16",
+					SerialNo:           "FY28BP4127",
+					Location:           "Port 2I Box 1",
+					ControllerLocation: "Slot 0",
+					TotalBays:          14,
+					Condition:          StatusOK,
+					FanStatus:          EnclosureComponentStatusNotPresent,
+					TemperatureStatus:  EnclosureComponentStatusNotPresent,
+					PowerSupplyStatus:  EnclosureComponentStatusNotPresent,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			mib := newTestingMIB(t, test.Generation)
+			enclosures, err := mib.StorageEnclosures()
+			require.NoError(t, err, "failed to retrieve storage enclosures from the MIB")
+			assert.Equal(t, test.Expected, enclosures)
+		})
+	}
+}
+
+func TestMIB_MemoryBoards(t *testing.T) {
+	tests := []struct {
+		Name       string
+		Expected   []MemoryBoard
+		Generation int
+	}{
+		{
+			Name:       "ProLiant DL380 Generation 7 Memory Boards",
+			Generation: 7,
+			Expected: []MemoryBoard{
+				{
+					ID:            1,
+					CPUNumber:     1,
+					RiserNumber:   0,
+					OnlineStatus:  MemoryBoardOnlineStatusPresent,
+					ErrorStatus:   MemoryBoardErrorStatusAdvancedECC,
+					Condition:     StatusOK,
+					TotalSlots:    9,
+					OSMemoryMB:    98304,
+					TotalMemoryMB: 98304,
+					FrequencyMHz:  800,
+					VoltageMV:     0,
+				},
+				{
+					ID:            2,
+					CPUNumber:     2,
+					RiserNumber:   0,
+					OnlineStatus:  MemoryBoardOnlineStatusPresent,
+					ErrorStatus:   MemoryBoardErrorStatusAdvancedECC,
+					Condition:     StatusOK,
+					TotalSlots:    9,
+					OSMemoryMB:    98304,
+					TotalMemoryMB: 98304,
+					FrequencyMHz:  800,
+					VoltageMV:     0,
+				},
+			},
+		},
+		{
+			Name:       "ProLiant DL380 Generation 8 Memory Boards",
+			Generation: 8,
+			Expected: []MemoryBoard{
+				{
+					ID:            1,
+					CPUNumber:     1,
+					RiserNumber:   0,
+					OnlineStatus:  MemoryBoardOnlineStatusPresent,
+					ErrorStatus:   MemoryBoardErrorStatusAdvancedECC,
+					Condition:     StatusOK,
+					TotalSlots:    12,
+					OSMemoryMB:    131072,
+					TotalMemoryMB: 131072,
+					FrequencyMHz:  1600,
+					VoltageMV:     1500,
+				},
+				{
+					ID:            2,
+					CPUNumber:     2,
+					RiserNumber:   0,
+					OnlineStatus:  MemoryBoardOnlineStatusPresent,
+					ErrorStatus:   MemoryBoardErrorStatusAdvancedECC,
+					Condition:     StatusOK,
+					TotalSlots:    12,
+					OSMemoryMB:    131072,
+					TotalMemoryMB: 131072,
+					FrequencyMHz:  1600,
+					VoltageMV:     1500,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			mib := newTestingMIB(t, test.Generation)
+			boards, err := mib.MemoryBoards()
+			require.NoError(t, err, "failed to retrieve memory boards from the MIB")
+			assert.Equal(t, test.Expected, boards)
+		})
+	}
+}
+
+func TestMIB_AdvancedMemoryProtection(t *testing.T) {
+	tests := []struct {
+		Name       string
+		Expected   MemoryProtectionMode
+		Generation int
+	}{
+		{
+			Name:       "ProLiant DL380 Generation 7 Advanced Memory Protection",
+			Generation: 7,
+			Expected:   MemoryProtectionModeAdvancedECC,
+		},
+		{
+			Name:       "ProLiant DL380 Generation 8 Advanced Memory Protection",
+			Generation: 8,
+			Expected:   MemoryProtectionModeAdvancedECC,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			mib := newTestingMIB(t, test.Generation)
+			mode, err := mib.AdvancedMemoryProtection()
+			require.NoError(t, err, "failed to retrieve advanced memory protection mode from the MIB")
+			assert.Equal(t, test.Expected, mode)
+		})
+	}
+}
+
+func TestMIB_DIMMs(t *testing.T) {
+	tests := []struct {
+		Name       string
+		Expected   []DIMM
+		Generation int
+	}{
+		{
+			Name:       "ProLiant DL380 Generation 7 DIMMs",
+			Generation: 7,
+			Expected: []DIMM{
+				{BoardID: 0, ID: 1, SizeKB: 0, Type: DIMMTypeDDR3, Technology: DIMMTechnologyRegistered, ECCStatus: DIMMECCStatusUnknown, SpeedMHz: 0},
+				{BoardID: 0, ID: 2, SizeKB: 16777216, Type: DIMMTypeDDR3, Technology: DIMMTechnologyRegistered, ECCStatus: DIMMECCStatusUnknown, SpeedMHz: 1067},
+				{BoardID: 0, ID: 3, SizeKB: 16777216, Type: DIMMTypeDDR3, Technology: DIMMTechnologyRegistered, ECCStatus: DIMMECCStatusUnknown, SpeedMHz: 1067},
+				{BoardID: 0, ID: 4, SizeKB: 0, Type: DIMMTypeDDR3, Technology: DIMMTechnologyRegistered, ECCStatus: DIMMECCStatusUnknown, SpeedMHz: 0},
+				{BoardID: 0, ID: 5, SizeKB: 16777216, Type: DIMMTypeDDR3, Technology: DIMMTechnologyRegistered, ECCStatus: DIMMECCStatusUnknown, SpeedMHz: 1067},
+				{BoardID: 0, ID: 6, SizeKB: 16777216, Type: DIMMTypeDDR3, Technology: DIMMTechnologyRegistered, ECCStatus: DIMMECCStatusUnknown, SpeedMHz: 1067},
+				{BoardID: 0, ID: 7, SizeKB: 0, Type: DIMMTypeDDR3, Technology: DIMMTechnologyRegistered, ECCStatus: DIMMECCStatusUnknown, SpeedMHz: 0},
+				{BoardID: 0, ID: 8, SizeKB: 16777216, Type: DIMMTypeDDR3, Technology: DIMMTechnologyRegistered, ECCStatus: DIMMECCStatusUnknown, SpeedMHz: 1067},
+				{BoardID: 0, ID: 9, SizeKB: 16777216, Type: DIMMTypeDDR3, Technology: DIMMTechnologyRegistered, ECCStatus: DIMMECCStatusUnknown, SpeedMHz: 1067},
+			},
+		},
+		{
+			Name:       "ProLiant DL380 Generation 8 DIMMs",
+			Generation: 8,
+			Expected: []DIMM{
+				{BoardID: 0, ID: 1, SizeKB: 16777216, Type: DIMMTypeDDR3, Technology: DIMMTechnologyRegistered, ECCStatus: DIMMECCStatusUnknown, SpeedMHz: 1600},
+				{BoardID: 0, ID: 2, SizeKB: 16777216, Type: DIMMTypeDDR3, Technology: DIMMTechnologyRegistered, ECCStatus: DIMMECCStatusUnknown, SpeedMHz: 1600},
+				{BoardID: 0, ID: 3, SizeKB: 0, Type: DIMMTypeDDR3, Technology: DIMMTechnologySynchronous, ECCStatus: DIMMECCStatusUnknown, SpeedMHz: 0},
+				{BoardID: 0, ID: 4, SizeKB: 16777216, Type: DIMMTypeDDR3, Technology: DIMMTechnologyRegistered, ECCStatus: DIMMECCStatusUnknown, SpeedMHz: 1600},
+				{BoardID: 0, ID: 5, SizeKB: 16777216, Type: DIMMTypeDDR3, Technology: DIMMTechnologyRegistered, ECCStatus: DIMMECCStatusUnknown, SpeedMHz: 1600},
+				{BoardID: 0, ID: 6, SizeKB: 0, Type: DIMMTypeDDR3, Technology: DIMMTechnologySynchronous, ECCStatus: DIMMECCStatusUnknown, SpeedMHz: 0},
+				{BoardID: 0, ID: 7, SizeKB: 0, Type: DIMMTypeDDR3, Technology: DIMMTechnologySynchronous, ECCStatus: DIMMECCStatusUnknown, SpeedMHz: 0},
+				{BoardID: 0, ID: 8, SizeKB: 16777216, Type: DIMMTypeDDR3, Technology: DIMMTechnologyRegistered, ECCStatus: DIMMECCStatusUnknown, SpeedMHz: 1600},
+				{BoardID: 0, ID: 9, SizeKB: 16777216, Type: DIMMTypeDDR3, Technology: DIMMTechnologyRegistered, ECCStatus: DIMMECCStatusUnknown, SpeedMHz: 1600},
+				{BoardID: 0, ID: 10, SizeKB: 0, Type: DIMMTypeDDR3, Technology: DIMMTechnologySynchronous, ECCStatus: DIMMECCStatusUnknown, SpeedMHz: 0},
+				{BoardID: 0, ID: 11, SizeKB: 16777216, Type: DIMMTypeDDR3, Technology: DIMMTechnologyRegistered, ECCStatus: DIMMECCStatusUnknown, SpeedMHz: 1600},
+				{BoardID: 0, ID: 12, SizeKB: 16777216, Type: DIMMTypeDDR3, Technology: DIMMTechnologyRegistered, ECCStatus: DIMMECCStatusUnknown, SpeedMHz: 1600},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			mib := newTestingMIB(t, test.Generation)
+			dimms, err := mib.DIMMs()
+			require.NoError(t, err, "failed to retrieve DIMMs from the MIB")
+			assert.Equal(t, test.Expected, dimms)
+		})
+	}
+}
+
+func TestMIB_ASR(t *testing.T) {
+	tests := []struct {
+		Name       string
+		Expected   ASR
+		Generation int
+	}{
+		{
+			Name:       "ProLiant DL380 Generation 7 ASR",
+			Generation: 7,
+			Expected: ASR{
+				State:              ASRStateEnabled,
+				TimeoutMinutes:     10,
+				POSTTimeoutEnabled: false,
+				POSTTimeoutMinutes: 10,
+				ResetCount:         0,
+				ResetLimit:         1,
+				LastResetCause:     ASRResetCauseNone,
+				Condition:          StatusOK,
+			},
+		},
+		{
+			Name:       "ProLiant DL380 Generation 8 ASR",
+			Generation: 8,
+			Expected: ASR{
+				State:              ASRStateEnabled,
+				TimeoutMinutes:     10,
+				POSTTimeoutEnabled: false,
+				POSTTimeoutMinutes: 10,
+				ResetCount:         0,
+				ResetLimit:         1,
+				LastResetCause:     ASRResetCauseNone,
+				Condition:          StatusOK,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			mib := newTestingMIB(t, test.Generation)
+			asr, err := mib.ASR()
+			require.NoError(t, err, "failed to retrieve ASR configuration from the MIB")
+			assert.Equal(t, test.Expected, asr)
+		})
+	}
+}
+
+func TestMIB_PowerMeter(t *testing.T) {
+	tests := []struct {
+		Name       string
+		Expected   PowerMeter
+		Generation int
+	}{
+		{
+			Name:       "ProLiant DL380 Generation 7 Power Meter",
+			Generation: 7,
+			Expected: PowerMeter{
+				Supported:     true,
+				Status:        StatusOK,
+				CurrentWatts:  144,
+				PreviousWatts: 144,
+			},
+		},
+		{
+			Name:       "ProLiant DL380 Generation 8 Power Meter",
+			Generation: 8,
+			Expected: PowerMeter{
+				Supported:     true,
+				Status:        StatusOK,
+				CurrentWatts:  130,
+				PreviousWatts: 130,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			mib := newTestingMIB(t, test.Generation)
+			meter, err := mib.PowerMeter()
+			require.NoError(t, err, "failed to retrieve power meter from the MIB")
+			assert.Equal(t, test.Expected, meter)
+		})
+	}
+}
+
+func TestMIB_SpareDrives(t *testing.T) {
+	tests := []struct {
+		Name       string
+		Expected   []SpareDrive
+		Generation int
+	}{
+		{
+			Name:       "ProLiant DL380 Generation 7 Spare Drives",
+			Generation: 7,
+			Expected:   []SpareDrive{},
+		},
+		{
+			Name:       "ProLiant DL380 Generation 8 Spare Drives",
+			Generation: 8,
+			Expected:   []SpareDrive{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			mib := newTestingMIB(t, test.Generation)
+			spareDrives, err := mib.SpareDrives()
+			require.NoError(t, err, "failed to retrieve spare drives from the MIB")
+			assert.Equal(t, test.Expected, spareDrives)
+		})
+	}
+}
+
+func TestMIB_SystemInfo(t *testing.T) {
+	tests := []struct {
+		Name       string
+		Expected   SystemInfo
+		Generation int
+	}{
+		{
+			Name:       "ProLiant DL380 Generation 7 System Info",
+			Generation: 7,
+			Expected: SystemInfo{
+				SerialNumber:        "CZ21470BB8",
+				Model:               "ProLiant DL380 G7",
+				ProductID:           "583966-421",
+				AssetTag:            "",
+				UUID:                "39333835-3636-5A43-3231-343730424238",
+				FormFactor:          7,
+				SystemID:            "CPQ07AD",
+				ROMVersion:          "05/05/2011, Family P67",
+				ROMFamily:           "P67",
+				ROMDate:             time.Date(2011, 5, 5, 0, 0, 0, 0, time.UTC),
+				RedundantROMVersion: "05/05/2011, Family P67",
+			},
+		},
+		{
+			Name:       "ProLiant DL380 Generation 8 System Info",
+			Generation: 8,
+			Expected: SystemInfo{
+				SerialNumber:        "USE31629DN",
+				Model:               "ProLiant DL380p Gen8",
+				ProductID:           "706539-S01",
+				AssetTag:            "",
+				UUID:                "35363037-3933-5355-4533-31363239444E",
+				FormFactor:          7,
+				SystemID:            "CPQ07BB",
+				ROMVersion:          "03/01/2013, Family P70",
+				ROMFamily:           "P70",
+				ROMDate:             time.Date(2013, 3, 1, 0, 0, 0, 0, time.UTC),
+				RedundantROMVersion: "03/01/2013, Family P70",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			mib := newTestingMIB(t, test.Generation)
+			info, err := mib.SystemInfo()
+			require.NoError(t, err, "failed to retrieve system info from the MIB")
+			assert.Equal(t, test.Expected, info)
+		})
+	}
+}
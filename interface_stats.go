@@ -0,0 +1,111 @@
+package hpmib
+
+import (
+	"strconv"
+)
+
+// InterfaceStats models the throughput of a network interface reported by the host OS agents in the HP MIB.
+// Throughput is measured over the most recent polling interval of the agent, which is typically 30 seconds.
+// The byte and packet counts are totals for that interval rather than cumulative counters, so they should
+// not be differenced between polls.
+type InterfaceStats struct {
+	// ID is the index of this network interface.
+	ID int
+	// Name is the name of this network interface in the host OS, e.g. "eth0", which matches
+	// NetworkAdapter.InterfaceName.
+	Name string
+	// RxBytes is the number of bytes received during the most recent polling interval of the agent.
+	RxBytes uint32
+	// RxPackets is the number of packets received during the most recent polling interval of the agent.
+	RxPackets uint32
+	// RxBytesPerSec is the number of bytes received per second.
+	RxBytesPerSec uint32
+	// RxPacketsPerSec is the number of packets received per second.
+	RxPacketsPerSec uint32
+	// TxBytes is the number of bytes transmitted during the most recent polling interval of the agent.
+	TxBytes uint32
+	// TxPackets is the number of packets transmitted during the most recent polling interval of the agent.
+	TxPackets uint32
+	// TxBytesPerSec is the number of bytes transmitted per second.
+	TxBytesPerSec uint32
+	// TxPacketsPerSec is the number of packets transmitted per second.
+	TxPacketsPerSec uint32
+}
+
+// Table defined by the HP MIB that contains the throughput of each network interface.
+const (
+	cpqLinOsNetworkInterfaceIndex         OID = "1.3.6.1.4.1.232.23.2.10.2.1.1"
+	cpqLinOsNetworkInterfaceName          OID = "1.3.6.1.4.1.232.23.2.10.2.1.2"
+	cpqLinOsNetworkInterfaceRxBytes       OID = "1.3.6.1.4.1.232.23.2.10.2.1.3"
+	cpqLinOsNetworkInterfaceRxPackets     OID = "1.3.6.1.4.1.232.23.2.10.2.1.4"
+	cpqLinOsNetworkInterfaceRxBytesPerSec OID = "1.3.6.1.4.1.232.23.2.10.2.1.5"
+	cpqLinOsNetworkInterfaceRxPktsPerSec  OID = "1.3.6.1.4.1.232.23.2.10.2.1.6"
+	cpqLinOsNetworkInterfaceTxBytes       OID = "1.3.6.1.4.1.232.23.2.10.2.1.7"
+	cpqLinOsNetworkInterfaceTxPackets     OID = "1.3.6.1.4.1.232.23.2.10.2.1.8"
+	cpqLinOsNetworkInterfaceTxBytesPerSec OID = "1.3.6.1.4.1.232.23.2.10.2.1.9"
+	cpqLinOsNetworkInterfaceTxPktsPerSec  OID = "1.3.6.1.4.1.232.23.2.10.2.1.10"
+)
+
+// InterfaceStats returns the throughput of each network interface in the host OS. Returns a non-nil
+// error if the throughput could not be determined.
+func (m *MIB) InterfaceStats() ([]InterfaceStats, error) {
+	stats := []InterfaceStats{}
+
+	columns := OIDList{
+		cpqLinOsNetworkInterfaceIndex,
+		cpqLinOsNetworkInterfaceName,
+		cpqLinOsNetworkInterfaceRxBytes,
+		cpqLinOsNetworkInterfaceRxPackets,
+		cpqLinOsNetworkInterfaceRxBytesPerSec,
+		cpqLinOsNetworkInterfaceRxPktsPerSec,
+		cpqLinOsNetworkInterfaceTxBytes,
+		cpqLinOsNetworkInterfaceTxPackets,
+		cpqLinOsNetworkInterfaceTxBytesPerSec,
+		cpqLinOsNetworkInterfaceTxPktsPerSec,
+	}
+	table, err := traverseTable(m.snmpClient, columns)
+	if err != nil {
+		return []InterfaceStats{}, err
+	}
+
+	for _, row := range table {
+		stat, err := parseInterfaceStats(row)
+		if err != nil {
+			return []InterfaceStats{}, err
+		}
+		stats = append(stats, stat)
+	}
+
+	return stats, nil
+}
+
+// parseInterfaceStats parses a row of the network interface table, whose columns are ordered as in
+// InterfaceStats.
+func parseInterfaceStats(row []string) (InterfaceStats, error) {
+	index, err := strconv.Atoi(row[0])
+	if err != nil {
+		return InterfaceStats{}, err
+	}
+	name := prettifyString(row[1])
+	counters := make([]uint32, 0, len(row[2:]))
+	for _, col := range row[2:] {
+		counter, err := strconv.ParseUint(col, 10, 32)
+		if err != nil {
+			return InterfaceStats{}, err
+		}
+		counters = append(counters, uint32(counter))
+	}
+
+	return InterfaceStats{
+		ID:              index,
+		Name:            name,
+		RxBytes:         counters[0],
+		RxPackets:       counters[1],
+		RxBytesPerSec:   counters[2],
+		RxPacketsPerSec: counters[3],
+		TxBytes:         counters[4],
+		TxPackets:       counters[5],
+		TxBytesPerSec:   counters[6],
+		TxPacketsPerSec: counters[7],
+	}, nil
+}
@@ -2,7 +2,6 @@ package hpmib
 
 import (
 	"strconv"
-	"strings"
 )
 
 // LogicalDriveStatus describes the state of a logical drive.
@@ -11,14 +10,18 @@ type LogicalDriveStatus int
 // FaultTolerance describes the fault tolerance of a logical drive.
 type FaultTolerance int
 
+// LogicalDriveCacheStatus describes the state of the array accelerator cache for a logical drive.
+type LogicalDriveCacheStatus int
+
 // LogicalDrive models a logical drive in the HP MIB.
 type LogicalDrive struct {
 	// ID is the index of this logical drive.
 	ID int
 	// Name is this logical drive's name that is presented to the OS.
 	Name string
-	// AvailableSpares contains a list of physical drive IDs that that are available spares for this logical drive.
-	AvailableSpares []string
+	// AvailableSpares contains the IDs of the physical drives that are available spares for this logical drive.
+	// See SpareDrives for the state of each spare.
+	AvailableSpares []int
 	// ControllerID is the index of this logical drives' controller.
 	ControllerID int
 	// CapacityMB is the total capacity of this logical drive in megabytes.
@@ -29,6 +32,27 @@ type LogicalDrive struct {
 	Status LogicalDriveStatus
 	// FaultTolerance is the fault tolerance mode of this logical drive.
 	FaultTolerance FaultTolerance
+	// PercentRebuild is the percentage of the rebuild of this logical drive that has completed.
+	// Set to -1 if this logical drive is not rebuilding or if not reported by the agent.
+	PercentRebuild int
+	// RebuildingDriveID is the ID of the physical drive that is being rebuilt onto.
+	// Set to -1 if this logical drive is not rebuilding or if not reported by the agent.
+	RebuildingDriveID int
+	// StripSizeKB is the amount of data written to each physical drive before moving on to the next in kilobytes.
+	// Set to -1 if not reported by the agent.
+	StripSizeKB int
+	// StripeSizeKB is the amount of data in a full stripe across the data drives of this logical drive in kilobytes.
+	// Set to -1 if the number of data drives cannot be determined from the fault tolerance mode or the strip
+	// size is not reported by the agent.
+	StripeSizeKB int
+	// CacheStatus is the state of the array accelerator cache for this logical drive.
+	// Set to LogicalDriveCacheStatusUnknown if not reported by the agent.
+	CacheStatus LogicalDriveCacheStatus
+	// PhysicalDriveIDs contains the IDs of the physical drives that are members of this logical drive.
+	// Empty if not reported by the agent.
+	PhysicalDriveIDs []int
+	// SpareReplacements maps the ID of each failed physical drive to the ID of the spare drive that replaced it.
+	SpareReplacements map[int]int
 }
 
 // Fault tolerance modes for logical drives defined by the HP MIB.
@@ -66,16 +90,31 @@ const (
 	LogicalDriveStatusUnknown                 LogicalDriveStatus = -1
 )
 
+// Cache statuses for logical drives defined by the HP MIB.
+const (
+	LogicalDriveCacheStatusUnknown     LogicalDriveCacheStatus = -1
+	LogicalDriveCacheStatusOther       LogicalDriveCacheStatus = 1
+	LogicalDriveCacheStatusUnavailable LogicalDriveCacheStatus = 2
+	LogicalDriveCacheStatusEnabled     LogicalDriveCacheStatus = 3
+	LogicalDriveCacheStatusDisabled    LogicalDriveCacheStatus = 4
+)
+
 // Table defined by the HP MIB that contains the status of each logical drive.
 const (
-	cpqDaLogDrvCntlrIndex  OID = "1.3.6.1.4.1.232.3.2.3.1.1.1"
-	cpqDaLogDrvIndex       OID = "1.3.6.1.4.1.232.3.2.3.1.1.2"
-	cpqDaLogDrvFaultTol    OID = "1.3.6.1.4.1.232.3.2.3.1.1.3"
-	cpqDaLogDrvStatus      OID = "1.3.6.1.4.1.232.3.2.3.1.1.4"
-	cpqDaLogDrvAvailSpares OID = "1.3.6.1.4.1.232.3.2.3.1.1.8"
-	cpqDaLogDrvSize        OID = "1.3.6.1.4.1.232.3.2.3.1.1.9"
-	cpqDaLogDrvCondition   OID = "1.3.6.1.4.1.232.3.2.3.1.1.11"
-	cpqDaLogDrvOsName      OID = "1.3.6.1.4.1.232.3.2.3.1.1.14"
+	cpqDaLogDrvCntlrIndex      OID = "1.3.6.1.4.1.232.3.2.3.1.1.1"
+	cpqDaLogDrvIndex           OID = "1.3.6.1.4.1.232.3.2.3.1.1.2"
+	cpqDaLogDrvFaultTol        OID = "1.3.6.1.4.1.232.3.2.3.1.1.3"
+	cpqDaLogDrvStatus          OID = "1.3.6.1.4.1.232.3.2.3.1.1.4"
+	cpqDaLogDrvHasAccel        OID = "1.3.6.1.4.1.232.3.2.3.1.1.7"
+	cpqDaLogDrvAvailSpares     OID = "1.3.6.1.4.1.232.3.2.3.1.1.8"
+	cpqDaLogDrvSize            OID = "1.3.6.1.4.1.232.3.2.3.1.1.9"
+	cpqDaLogDrvPhyDrvIDs       OID = "1.3.6.1.4.1.232.3.2.3.1.1.10"
+	cpqDaLogDrvCondition       OID = "1.3.6.1.4.1.232.3.2.3.1.1.11"
+	cpqDaLogDrvPercentRebuild  OID = "1.3.6.1.4.1.232.3.2.3.1.1.12"
+	cpqDaLogDrvStripeSize      OID = "1.3.6.1.4.1.232.3.2.3.1.1.13"
+	cpqDaLogDrvOsName          OID = "1.3.6.1.4.1.232.3.2.3.1.1.14"
+	cpqDaLogDrvSpareReplaceMap OID = "1.3.6.1.4.1.232.3.2.3.1.1.16"
+	cpqDaLogDrvRebuildingDisk  OID = "1.3.6.1.4.1.232.3.2.3.1.1.17"
 )
 
 var (
@@ -120,6 +159,18 @@ var (
 		"15": LogicalDriveStatusMultipathAccessDegraded,
 		"16": LogicalDriveStatusErasing,
 	}
+	logicalDriveCacheStatusIDMappings = map[string]LogicalDriveCacheStatus{
+		"1": LogicalDriveCacheStatusOther,
+		"2": LogicalDriveCacheStatusUnavailable,
+		"3": LogicalDriveCacheStatusEnabled,
+		"4": LogicalDriveCacheStatusDisabled,
+	}
+	logicalDriveCacheStatusHumanMappings = map[LogicalDriveCacheStatus]string{
+		LogicalDriveCacheStatusOther:       "Other",
+		LogicalDriveCacheStatusUnavailable: "Unavailable",
+		LogicalDriveCacheStatusEnabled:     "Enabled",
+		LogicalDriveCacheStatusDisabled:    "Disabled",
+	}
 	logicalDriveStatusHumanMappings = map[LogicalDriveStatus]string{
 		LogicalDriveStatusOK:                      "OK",
 		LogicalDriveStatusFailed:                  "Failed",
@@ -159,6 +210,22 @@ func (m *MIB) LogicalDrives() ([]LogicalDrive, error) {
 		return []LogicalDrive{}, err
 	}
 
+	// Integer attributes are listed in the order they are assigned to each logical drive.
+	intColumns := OIDList{
+		cpqDaLogDrvPercentRebuild,
+		cpqDaLogDrvStripeSize,
+		cpqDaLogDrvRebuildingDisk,
+	}
+	optionalColumns := append(OIDList{
+		cpqDaLogDrvHasAccel,
+		cpqDaLogDrvPhyDrvIDs,
+		cpqDaLogDrvSpareReplaceMap,
+	}, intColumns...)
+	optional, err := traverseOptionalColumns(m.snmpClient, optionalColumns)
+	if err != nil {
+		return []LogicalDrive{}, err
+	}
+
 	for _, row := range table {
 		cntlrIndex, err := strconv.Atoi(row[0])
 		if err != nil {
@@ -170,22 +237,45 @@ func (m *MIB) LogicalDrives() ([]LogicalDrive, error) {
 		}
 		faultTol := parseFaultTolerance(row[2])
 		condition := parseStatus(row[3])
-		availSpares := parseAvailableSpares(row[4])
+		availSpares := parseDriveIDs(row[4])
 		size, err := strconv.Atoi(row[5])
 		if err != nil {
 			return []LogicalDrive{}, err
 		}
 		status := parseLogicalDriveStatus(row[6])
 		osName := row[7]
+
+		key := row[0] + "." + row[1]
+		cacheStatus := parseLogicalDriveCacheStatus(optional[cpqDaLogDrvHasAccel][key])
+		phyDrvIDs := parseDriveIDs(optional[cpqDaLogDrvPhyDrvIDs][key])
+		spareReplacements := parseSpareReplaceMap(optional[cpqDaLogDrvSpareReplaceMap][key])
+		// The rebuild percentage is set to the maximum value of the gauge if the logical drive is not
+		// rebuilding, and the rebuilding disk is set to -1. Despite its name, the stripe size column
+		// holds the amount of data written to each physical drive.
+		var ints [3]int
+		for i, column := range intColumns {
+			ints[i], err = parseOptionalInt(optional[column], key)
+			if err != nil {
+				return []LogicalDrive{}, err
+			}
+		}
+
 		logicalDrives = append(logicalDrives, LogicalDrive{
-			ControllerID:    cntlrIndex,
-			ID:              index,
-			FaultTolerance:  faultTol,
-			Condition:       condition,
-			AvailableSpares: availSpares,
-			Status:          status,
-			CapacityMB:      size,
-			Name:            osName,
+			ControllerID:      cntlrIndex,
+			ID:                index,
+			FaultTolerance:    faultTol,
+			Condition:         condition,
+			AvailableSpares:   availSpares,
+			Status:            status,
+			CapacityMB:        size,
+			Name:              osName,
+			PercentRebuild:    ints[0],
+			RebuildingDriveID: ints[2],
+			StripSizeKB:       ints[1],
+			StripeSizeKB:      stripeSize(faultTol, ints[1], len(phyDrvIDs)),
+			CacheStatus:       cacheStatus,
+			PhysicalDriveIDs:  phyDrvIDs,
+			SpareReplacements: spareReplacements,
 		})
 	}
 
@@ -211,14 +301,51 @@ func (f *FaultTolerance) String() string {
 	return s
 }
 
-// parseAvailableSpares returns the list of spares from the given space delimited string.
-// Returns an empty list of there are no available spares.
-func parseAvailableSpares(s string) []string {
-	spares := strings.Split(s, " ")
-	if len(spares) == 1 && spares[0] == "" {
-		return []string{}
+// parseDriveIDs returns the list of physical drive IDs from the given string, which contains
+// one octet per physical drive.
+func parseDriveIDs(s string) []int {
+	ids := []int{}
+	for _, b := range []byte(s) {
+		ids = append(ids, int(b))
+	}
+	return ids
+}
+
+// parseSpareReplaceMap returns the spare drive replacing each failed physical drive from the given
+// string, which contains pairs of octets holding the ID of a failed physical drive followed by the
+// ID of the spare drive that replaced it.
+func parseSpareReplaceMap(s string) map[int]int {
+	replacements := map[int]int{}
+	b := []byte(s)
+	for i := 0; i+1 < len(b); i += 2 {
+		replacements[int(b[i])] = int(b[i+1])
+	}
+	return replacements
+}
+
+// stripeSize returns the size of a full stripe across the data drives of a logical drive with the given
+// fault tolerance mode, strip size and number of physical drives. Returns -1 if the number of data drives
+// cannot be determined from the fault tolerance mode or the strip size is not known.
+func stripeSize(faultTol FaultTolerance, stripSize, drives int) int {
+	var dataDrives int
+	switch faultTol {
+	case FaultToleranceNone:
+		dataDrives = drives
+	case FaultToleranceMirroring, FaultToleranceRAID10:
+		dataDrives = drives / 2
+	case FaultToleranceRAID1ADM, FaultToleranceRAID10ADM:
+		dataDrives = drives / 3
+	case FaultToleranceDataGuard, FaultToleranceDistributedDataGuard:
+		dataDrives = drives - 1
+	case FaultToleranceAdvancedDataGuard:
+		dataDrives = drives - 2
+	default:
+		return -1
 	}
-	return spares
+	if dataDrives < 1 || stripSize < 0 {
+		return -1
+	}
+	return stripSize * dataDrives
 }
 
 // parseFaultTolerance returns the LogicalDriveStatus that corresponds with the given string.
@@ -239,3 +366,22 @@ func (l *LogicalDriveStatus) String() string {
 	}
 	return s
 }
+
+// parseLogicalDriveCacheStatus returns the LogicalDriveCacheStatus that corresponds with the given string ID.
+// Returns LogicalDriveCacheStatusUnknown if the given string ID cannot be not accounted for.
+func parseLogicalDriveCacheStatus(s string) LogicalDriveCacheStatus {
+	status, ok := logicalDriveCacheStatusIDMappings[s]
+	if !ok {
+		return LogicalDriveCacheStatusUnknown
+	}
+	return status
+}
+
+// String converts the LogicalDriveCacheStatus to a human readable string.
+func (l *LogicalDriveCacheStatus) String() string {
+	s, ok := logicalDriveCacheStatusHumanMappings[*l]
+	if !ok {
+		return "Unknown"
+	}
+	return s
+}
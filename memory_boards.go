@@ -0,0 +1,288 @@
+package hpmib
+
+import (
+	"strconv"
+)
+
+// MemoryBoardOnlineStatus describes whether a memory board is installed.
+type MemoryBoardOnlineStatus int
+
+// MemoryBoardErrorStatus describes the error or protection state of the memory on a memory board.
+type MemoryBoardErrorStatus int
+
+// MemoryProtectionMode describes the advanced memory protection mode configured for the system.
+type MemoryProtectionMode int
+
+// MemoryBoard models a memory board, memory riser or the memory slots of a processor in the HP MIB.
+type MemoryBoard struct {
+	// ID is the index of this memory board.
+	ID int
+	// CPUNumber is the number of the processor this memory board belongs to, which matches
+	// MemoryModule.CPUNumber.
+	CPUNumber int
+	// RiserNumber is the number of the memory riser, or 0 if the memory slots are on the system board.
+	RiserNumber int
+	// OnlineStatus indicates whether this memory board is installed.
+	OnlineStatus MemoryBoardOnlineStatus
+	// ErrorStatus is the error or protection state of the memory on this memory board.
+	ErrorStatus MemoryBoardErrorStatus
+	// Condition is the overall condition of the memory on this memory board.
+	Condition Status
+	// TotalSlots is the number of memory module slots on this memory board.
+	TotalSlots int
+	// OSMemoryMB is the amount of memory on this memory board that is available to the host OS in megabytes.
+	OSMemoryMB int
+	// TotalMemoryMB is the amount of memory installed on this memory board in megabytes.
+	TotalMemoryMB int
+	// FrequencyMHz is the operating frequency of the memory on this memory board.
+	FrequencyMHz int
+	// VoltageMV is the operating voltage of the memory on this memory board in millivolts. Set to -1 if
+	// not reported by the agent. Some agents report 0 if the voltage is not known.
+	VoltageMV int
+}
+
+// Table defined by the HP MIB that contains the status of each memory board. The per-CPU memory tables
+// at 232.6.2.14.10 and 232.6.2.14.11 are not read, as they repeat the values of this table and of the
+// memory module table keyed by processor.
+const (
+	cpqHeResMem2BoardIndex              OID = "1.3.6.1.4.1.232.6.2.14.12.1.1"
+	cpqHeResMem2BoardCPUIndex           OID = "1.3.6.1.4.1.232.6.2.14.12.1.3"
+	cpqHeResMem2BoardRiserIndex         OID = "1.3.6.1.4.1.232.6.2.14.12.1.4"
+	cpqHeResMem2BoardOnlineStatus       OID = "1.3.6.1.4.1.232.6.2.14.12.1.5"
+	cpqHeResMem2BoardErrorStatus        OID = "1.3.6.1.4.1.232.6.2.14.12.1.6"
+	cpqHeResMem2BoardNumSockets         OID = "1.3.6.1.4.1.232.6.2.14.12.1.8"
+	cpqHeResMem2BoardOsMemSize          OID = "1.3.6.1.4.1.232.6.2.14.12.1.9"
+	cpqHeResMem2BoardTotalMemSize       OID = "1.3.6.1.4.1.232.6.2.14.12.1.10"
+	cpqHeResMem2BoardCondition          OID = "1.3.6.1.4.1.232.6.2.14.12.1.11"
+	cpqHeResMem2BoardOperatingFrequency OID = "1.3.6.1.4.1.232.6.2.14.12.1.13"
+	cpqHeResMem2BoardOperatingVoltage   OID = "1.3.6.1.4.1.232.6.2.14.12.1.14"
+)
+
+// OIDs defined by the HP MIB that describe the advanced memory protection sub-system.
+const (
+	cpqHeResMemAdvancedMemoryProtection OID = "1.3.6.1.4.1.232.6.2.14.1"
+)
+
+// Online statuses for a memory board defined by the HP MIB.
+const (
+	MemoryBoardOnlineStatusUnknown MemoryBoardOnlineStatus = -1
+	MemoryBoardOnlineStatusOther   MemoryBoardOnlineStatus = 1
+	MemoryBoardOnlineStatusPresent MemoryBoardOnlineStatus = 2
+	MemoryBoardOnlineStatusAbsent  MemoryBoardOnlineStatus = 3
+)
+
+// Error statuses for a memory board defined by the HP MIB.
+const (
+	MemoryBoardErrorStatusUnknown           MemoryBoardErrorStatus = -1
+	MemoryBoardErrorStatusOther             MemoryBoardErrorStatus = 1
+	MemoryBoardErrorStatusNoError           MemoryBoardErrorStatus = 2
+	MemoryBoardErrorStatusDIMMECCError      MemoryBoardErrorStatus = 3
+	MemoryBoardErrorStatusUnlockError       MemoryBoardErrorStatus = 4
+	MemoryBoardErrorStatusConfigError       MemoryBoardErrorStatus = 5
+	MemoryBoardErrorStatusBusError          MemoryBoardErrorStatus = 6
+	MemoryBoardErrorStatusPowerError        MemoryBoardErrorStatus = 7
+	MemoryBoardErrorStatusAdvancedECC       MemoryBoardErrorStatus = 8
+	MemoryBoardErrorStatusOnlineSpare       MemoryBoardErrorStatus = 9
+	MemoryBoardErrorStatusMirrored          MemoryBoardErrorStatus = 10
+	MemoryBoardErrorStatusMirroredDIMMError MemoryBoardErrorStatus = 11
+	MemoryBoardErrorStatusMemoryRAID        MemoryBoardErrorStatus = 12
+	MemoryBoardErrorStatusEnteringLockstep  MemoryBoardErrorStatus = 13
+	MemoryBoardErrorStatusLockstep          MemoryBoardErrorStatus = 14
+)
+
+// Advanced memory protection modes defined by the HP MIB.
+const (
+	MemoryProtectionModeUnknown      MemoryProtectionMode = -1
+	MemoryProtectionModeOther        MemoryProtectionMode = 1
+	MemoryProtectionModeNotProtected MemoryProtectionMode = 2
+	MemoryProtectionModeOnlineSpare  MemoryProtectionMode = 3
+	MemoryProtectionModeMirrored     MemoryProtectionMode = 4
+	MemoryProtectionModeAdvancedECC  MemoryProtectionMode = 5
+	MemoryProtectionModeLockstep     MemoryProtectionMode = 6
+)
+
+var (
+	memoryBoardOnlineStatusIDMappings = map[string]MemoryBoardOnlineStatus{
+		"1": MemoryBoardOnlineStatusOther,
+		"2": MemoryBoardOnlineStatusPresent,
+		"3": MemoryBoardOnlineStatusAbsent,
+	}
+	memoryBoardOnlineStatusHumanMappings = map[MemoryBoardOnlineStatus]string{
+		MemoryBoardOnlineStatusOther:   "Other",
+		MemoryBoardOnlineStatusPresent: "Present",
+		MemoryBoardOnlineStatusAbsent:  "Absent",
+	}
+	memoryBoardErrorStatusIDMappings = map[string]MemoryBoardErrorStatus{
+		"1":  MemoryBoardErrorStatusOther,
+		"2":  MemoryBoardErrorStatusNoError,
+		"3":  MemoryBoardErrorStatusDIMMECCError,
+		"4":  MemoryBoardErrorStatusUnlockError,
+		"5":  MemoryBoardErrorStatusConfigError,
+		"6":  MemoryBoardErrorStatusBusError,
+		"7":  MemoryBoardErrorStatusPowerError,
+		"8":  MemoryBoardErrorStatusAdvancedECC,
+		"9":  MemoryBoardErrorStatusOnlineSpare,
+		"10": MemoryBoardErrorStatusMirrored,
+		"11": MemoryBoardErrorStatusMirroredDIMMError,
+		"12": MemoryBoardErrorStatusMemoryRAID,
+		"13": MemoryBoardErrorStatusEnteringLockstep,
+		"14": MemoryBoardErrorStatusLockstep,
+	}
+	memoryBoardErrorStatusHumanMappings = map[MemoryBoardErrorStatus]string{
+		MemoryBoardErrorStatusOther:             "Other",
+		MemoryBoardErrorStatusNoError:           "No Error",
+		MemoryBoardErrorStatusDIMMECCError:      "DIMM ECC Error",
+		MemoryBoardErrorStatusUnlockError:       "Unlock Error",
+		MemoryBoardErrorStatusConfigError:       "Config Error",
+		MemoryBoardErrorStatusBusError:          "Bus Error",
+		MemoryBoardErrorStatusPowerError:        "Power Error",
+		MemoryBoardErrorStatusAdvancedECC:       "Advanced ECC",
+		MemoryBoardErrorStatusOnlineSpare:       "Online Spare",
+		MemoryBoardErrorStatusMirrored:          "Mirrored",
+		MemoryBoardErrorStatusMirroredDIMMError: "Mirrored DIMM Error",
+		MemoryBoardErrorStatusMemoryRAID:        "Memory RAID",
+		MemoryBoardErrorStatusEnteringLockstep:  "Entering Lockstep",
+		MemoryBoardErrorStatusLockstep:          "Lockstep",
+	}
+	memoryProtectionModeIDMappings = map[string]MemoryProtectionMode{
+		"1": MemoryProtectionModeOther,
+		"2": MemoryProtectionModeNotProtected,
+		"3": MemoryProtectionModeOnlineSpare,
+		"4": MemoryProtectionModeMirrored,
+		"5": MemoryProtectionModeAdvancedECC,
+		"6": MemoryProtectionModeLockstep,
+	}
+	memoryProtectionModeHumanMappings = map[MemoryProtectionMode]string{
+		MemoryProtectionModeOther:        "Other",
+		MemoryProtectionModeNotProtected: "Not Protected",
+		MemoryProtectionModeOnlineSpare:  "Online Spare",
+		MemoryProtectionModeMirrored:     "Mirrored",
+		MemoryProtectionModeAdvancedECC:  "Advanced ECC",
+		MemoryProtectionModeLockstep:     "Lockstep",
+	}
+)
+
+// MemoryBoards returns a list of Memory Boards. Returns a non-nil error if the list of Memory Boards
+// could not be determined.
+func (m *MIB) MemoryBoards() ([]MemoryBoard, error) {
+	boards := []MemoryBoard{}
+
+	columns := OIDList{
+		cpqHeResMem2BoardIndex,
+		cpqHeResMem2BoardCPUIndex,
+		cpqHeResMem2BoardRiserIndex,
+		cpqHeResMem2BoardOnlineStatus,
+		cpqHeResMem2BoardErrorStatus,
+		cpqHeResMem2BoardNumSockets,
+		cpqHeResMem2BoardOsMemSize,
+		cpqHeResMem2BoardTotalMemSize,
+		cpqHeResMem2BoardCondition,
+		cpqHeResMem2BoardOperatingFrequency,
+	}
+	table, err := traverseTable(m.snmpClient, columns)
+	if err != nil {
+		return []MemoryBoard{}, err
+	}
+
+	optional, err := traverseOptionalColumns(m.snmpClient, OIDList{cpqHeResMem2BoardOperatingVoltage})
+	if err != nil {
+		return []MemoryBoard{}, err
+	}
+
+	for _, row := range table {
+		onlineStatus := parseMemoryBoardOnlineStatus(row[3])
+		errorStatus := parseMemoryBoardErrorStatus(row[4])
+		condition := parseStatus(row[8])
+		// The remaining columns are all integers.
+		ints := map[int]int{}
+		for _, i := range []int{0, 1, 2, 5, 6, 7, 9} {
+			value, err := strconv.Atoi(row[i])
+			if err != nil {
+				return []MemoryBoard{}, err
+			}
+			ints[i] = value
+		}
+		voltage, err := parseOptionalInt(optional[cpqHeResMem2BoardOperatingVoltage], row[0])
+		if err != nil {
+			return []MemoryBoard{}, err
+		}
+
+		boards = append(boards, MemoryBoard{
+			ID:            ints[0],
+			CPUNumber:     ints[1],
+			RiserNumber:   ints[2],
+			OnlineStatus:  onlineStatus,
+			ErrorStatus:   errorStatus,
+			Condition:     condition,
+			TotalSlots:    ints[5],
+			OSMemoryMB:    ints[6],
+			TotalMemoryMB: ints[7],
+			FrequencyMHz:  ints[9],
+			VoltageMV:     voltage,
+		})
+	}
+
+	return boards, nil
+}
+
+// AdvancedMemoryProtection returns the advanced memory protection mode configured for the system,
+// which determines how MemoryStatus should be interpreted.
+// Returns a non-nil error if the advanced memory protection mode could not be determined.
+func (m *MIB) AdvancedMemoryProtection() (MemoryProtectionMode, error) {
+	values, err := getScalars(m.snmpClient, OIDList{cpqHeResMemAdvancedMemoryProtection})
+	if err != nil {
+		return MemoryProtectionModeUnknown, err
+	}
+	return parseMemoryProtectionMode(values[0]), nil
+}
+
+func parseMemoryBoardOnlineStatus(s string) MemoryBoardOnlineStatus {
+	status, ok := memoryBoardOnlineStatusIDMappings[s]
+	if !ok {
+		return MemoryBoardOnlineStatusUnknown
+	}
+	return status
+}
+
+// String converts the MemoryBoardOnlineStatus to a human readable string.
+func (m *MemoryBoardOnlineStatus) String() string {
+	s, ok := memoryBoardOnlineStatusHumanMappings[*m]
+	if !ok {
+		return "Unknown"
+	}
+	return s
+}
+
+func parseMemoryBoardErrorStatus(s string) MemoryBoardErrorStatus {
+	status, ok := memoryBoardErrorStatusIDMappings[s]
+	if !ok {
+		return MemoryBoardErrorStatusUnknown
+	}
+	return status
+}
+
+// String converts the MemoryBoardErrorStatus to a human readable string.
+func (m *MemoryBoardErrorStatus) String() string {
+	s, ok := memoryBoardErrorStatusHumanMappings[*m]
+	if !ok {
+		return "Unknown"
+	}
+	return s
+}
+
+func parseMemoryProtectionMode(s string) MemoryProtectionMode {
+	mode, ok := memoryProtectionModeIDMappings[s]
+	if !ok {
+		return MemoryProtectionModeUnknown
+	}
+	return mode
+}
+
+// String converts the MemoryProtectionMode to a human readable string.
+func (m *MemoryProtectionMode) String() string {
+	s, ok := memoryProtectionModeHumanMappings[*m]
+	if !ok {
+		return "Unknown"
+	}
+	return s
+}
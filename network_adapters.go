@@ -0,0 +1,380 @@
+package hpmib
+
+import (
+	"encoding/binary"
+	"net"
+	"strconv"
+	"time"
+)
+
+// NetworkAdapterStatus describes the status of a physical network adapter.
+type NetworkAdapterStatus int
+
+// DuplexState describes the duplex state of a physical network adapter.
+type DuplexState int
+
+// NetworkAdapter models a physical network adapter in the HP MIB.
+type NetworkAdapter struct {
+	// ID is the index of this network adapter.
+	ID int
+	// IfIndex is the MIB-II interface index of this network adapter.
+	IfIndex int
+	// InterfaceName is the name of this network adapter's interface in the host OS, e.g. "eth0", which
+	// matches InterfaceStats.Name. Empty if the agent does not expose the MIB-II interfaces table.
+	InterfaceName string
+	// Name is the product name of this network adapter.
+	Name string
+	// MACAddress is the physical address of this network adapter.
+	MACAddress string
+	// SpeedMbps is the current speed of this network adapter in megabits per second.
+	SpeedMbps int
+	// DuplexState is the current duplex state of this network adapter.
+	DuplexState DuplexState
+	// Condition represents the overall condition of this network adapter.
+	Condition Status
+	// Status represents the current status of this network adapter.
+	Status NetworkAdapterStatus
+	// Statistics contains the traffic and error counters of this network adapter.
+	Statistics NetworkAdapterStatistics
+}
+
+// NetworkAdapterStatistics contains the traffic and error counters of a physical network adapter.
+// Each counter is a Counter32 that wraps around at 2^32.
+type NetworkAdapterStatistics struct {
+	// Valid is false if the agent was unable to collect statistics for this network adapter.
+	Valid                     bool
+	GoodTransmits             uint32
+	GoodReceives              uint32
+	BadTransmits              uint32
+	BadReceives               uint32
+	AlignmentErrors           uint32
+	FCSErrors                 uint32
+	SingleCollisionFrames     uint32
+	MultipleCollisionFrames   uint32
+	DeferredTransmissions     uint32
+	LateCollisions            uint32
+	ExcessiveCollisions       uint32
+	InternalMACTransmitErrors uint32
+	CarrierSenseErrors        uint32
+	FrameTooLongs             uint32
+	InternalMACReceiveErrors  uint32
+	InOctets                  uint32
+	OutOctets                 uint32
+}
+
+// NetworkAdapterRates contains the per-second rates of the traffic and error counters of a
+// physical network adapter, computed from two samples of NetworkAdapterStatistics.
+type NetworkAdapterRates struct {
+	GoodTransmits             float64
+	GoodReceives              float64
+	BadTransmits              float64
+	BadReceives               float64
+	AlignmentErrors           float64
+	FCSErrors                 float64
+	SingleCollisionFrames     float64
+	MultipleCollisionFrames   float64
+	DeferredTransmissions     float64
+	LateCollisions            float64
+	ExcessiveCollisions       float64
+	InternalMACTransmitErrors float64
+	CarrierSenseErrors        float64
+	FrameTooLongs             float64
+	InternalMACReceiveErrors  float64
+	InOctets                  float64
+	OutOctets                 float64
+}
+
+// Statuses for a physical network adapter defined by the HP MIB.
+const (
+	NetworkAdapterStatusUnknown        NetworkAdapterStatus = -1
+	NetworkAdapterStatusOther          NetworkAdapterStatus = 1
+	NetworkAdapterStatusOK             NetworkAdapterStatus = 2
+	NetworkAdapterStatusGeneralFailure NetworkAdapterStatus = 3
+	NetworkAdapterStatusLinkFailure    NetworkAdapterStatus = 4
+)
+
+// Duplex states for a physical network adapter defined by the HP MIB.
+const (
+	DuplexStateUnknown DuplexState = -1
+	DuplexStateOther   DuplexState = 1
+	DuplexStateHalf    DuplexState = 2
+	DuplexStateFull    DuplexState = 3
+)
+
+// Table defined by the HP MIB that contains the status and statistics of each physical network adapter.
+const (
+	cpqNicIfPhysAdapterIndex                     OID = "1.3.6.1.4.1.232.18.2.3.1.1.1"
+	cpqNicIfPhysAdapterIfNumber                  OID = "1.3.6.1.4.1.232.18.2.3.1.1.2"
+	cpqNicIfPhysAdapterMACAddress                OID = "1.3.6.1.4.1.232.18.2.3.1.1.4"
+	cpqNicIfPhysAdapterDuplexState               OID = "1.3.6.1.4.1.232.18.2.3.1.1.11"
+	cpqNicIfPhysAdapterCondition                 OID = "1.3.6.1.4.1.232.18.2.3.1.1.12"
+	cpqNicIfPhysAdapterStatus                    OID = "1.3.6.1.4.1.232.18.2.3.1.1.14"
+	cpqNicIfPhysAdapterStatsValid                OID = "1.3.6.1.4.1.232.18.2.3.1.1.15"
+	cpqNicIfPhysAdapterGoodTransmits             OID = "1.3.6.1.4.1.232.18.2.3.1.1.16"
+	cpqNicIfPhysAdapterGoodReceives              OID = "1.3.6.1.4.1.232.18.2.3.1.1.17"
+	cpqNicIfPhysAdapterBadTransmits              OID = "1.3.6.1.4.1.232.18.2.3.1.1.18"
+	cpqNicIfPhysAdapterBadReceives               OID = "1.3.6.1.4.1.232.18.2.3.1.1.19"
+	cpqNicIfPhysAdapterAlignmentErrors           OID = "1.3.6.1.4.1.232.18.2.3.1.1.20"
+	cpqNicIfPhysAdapterFCSErrors                 OID = "1.3.6.1.4.1.232.18.2.3.1.1.21"
+	cpqNicIfPhysAdapterSingleCollisionFrames     OID = "1.3.6.1.4.1.232.18.2.3.1.1.22"
+	cpqNicIfPhysAdapterMultipleCollisionFrames   OID = "1.3.6.1.4.1.232.18.2.3.1.1.23"
+	cpqNicIfPhysAdapterDeferredTransmissions     OID = "1.3.6.1.4.1.232.18.2.3.1.1.24"
+	cpqNicIfPhysAdapterLateCollisions            OID = "1.3.6.1.4.1.232.18.2.3.1.1.25"
+	cpqNicIfPhysAdapterExcessiveCollisions       OID = "1.3.6.1.4.1.232.18.2.3.1.1.26"
+	cpqNicIfPhysAdapterInternalMacTransmitErrors OID = "1.3.6.1.4.1.232.18.2.3.1.1.27"
+	cpqNicIfPhysAdapterCarrierSenseErrors        OID = "1.3.6.1.4.1.232.18.2.3.1.1.28"
+	cpqNicIfPhysAdapterFrameTooLongs             OID = "1.3.6.1.4.1.232.18.2.3.1.1.29"
+	cpqNicIfPhysAdapterInternalMacReceiveErrors  OID = "1.3.6.1.4.1.232.18.2.3.1.1.30"
+	cpqNicIfPhysAdapterSpeedMbps                 OID = "1.3.6.1.4.1.232.18.2.3.1.1.36"
+	cpqNicIfPhysAdapterInOctets                  OID = "1.3.6.1.4.1.232.18.2.3.1.1.37"
+	cpqNicIfPhysAdapterOutOctets                 OID = "1.3.6.1.4.1.232.18.2.3.1.1.38"
+	cpqNicIfPhysAdapterName                      OID = "1.3.6.1.4.1.232.18.2.3.1.1.39"
+)
+
+// Table defined by MIB-II that contains the name of each interface in the host OS.
+const (
+	ifIndex OID = "1.3.6.1.2.1.2.2.1.1"
+	ifDescr OID = "1.3.6.1.2.1.2.2.1.2"
+)
+
+var (
+	networkAdapterStatusIDMappings = map[string]NetworkAdapterStatus{
+		"1": NetworkAdapterStatusOther,
+		"2": NetworkAdapterStatusOK,
+		"3": NetworkAdapterStatusGeneralFailure,
+		"4": NetworkAdapterStatusLinkFailure,
+	}
+	networkAdapterStatusHumanMappings = map[NetworkAdapterStatus]string{
+		NetworkAdapterStatusOther:          "Other",
+		NetworkAdapterStatusOK:             "OK",
+		NetworkAdapterStatusGeneralFailure: "General Failure",
+		NetworkAdapterStatusLinkFailure:    "Link Failure",
+	}
+	duplexStateIDMappings = map[string]DuplexState{
+		"1": DuplexStateOther,
+		"2": DuplexStateHalf,
+		"3": DuplexStateFull,
+	}
+	duplexStateHumanMappings = map[DuplexState]string{
+		DuplexStateOther: "Other",
+		DuplexStateHalf:  "Half",
+		DuplexStateFull:  "Full",
+	}
+)
+
+// NetworkAdapters returns a list of physical Network Adapters. Returns a non-nil error if the list of
+// Network Adapters could not be determined.
+func (m *MIB) NetworkAdapters() ([]NetworkAdapter, error) {
+	adapters := []NetworkAdapter{}
+
+	interfaceNames, err := m.interfaceNames()
+	if err != nil {
+		return []NetworkAdapter{}, err
+	}
+
+	columns := OIDList{
+		cpqNicIfPhysAdapterIndex,
+		cpqNicIfPhysAdapterIfNumber,
+		cpqNicIfPhysAdapterMACAddress,
+		cpqNicIfPhysAdapterDuplexState,
+		cpqNicIfPhysAdapterCondition,
+		cpqNicIfPhysAdapterStatus,
+		cpqNicIfPhysAdapterStatsValid,
+		cpqNicIfPhysAdapterSpeedMbps,
+		cpqNicIfPhysAdapterName,
+		cpqNicIfPhysAdapterGoodTransmits,
+		cpqNicIfPhysAdapterGoodReceives,
+		cpqNicIfPhysAdapterBadTransmits,
+		cpqNicIfPhysAdapterBadReceives,
+		cpqNicIfPhysAdapterAlignmentErrors,
+		cpqNicIfPhysAdapterFCSErrors,
+		cpqNicIfPhysAdapterSingleCollisionFrames,
+		cpqNicIfPhysAdapterMultipleCollisionFrames,
+		cpqNicIfPhysAdapterDeferredTransmissions,
+		cpqNicIfPhysAdapterLateCollisions,
+		cpqNicIfPhysAdapterExcessiveCollisions,
+		cpqNicIfPhysAdapterInternalMacTransmitErrors,
+		cpqNicIfPhysAdapterCarrierSenseErrors,
+		cpqNicIfPhysAdapterFrameTooLongs,
+		cpqNicIfPhysAdapterInternalMacReceiveErrors,
+		cpqNicIfPhysAdapterInOctets,
+		cpqNicIfPhysAdapterOutOctets,
+	}
+	table, err := traverseTable(m.snmpClient, columns)
+	if err != nil {
+		return []NetworkAdapter{}, err
+	}
+
+	for _, row := range table {
+		adapter, err := parseNetworkAdapter(row, interfaceNames)
+		if err != nil {
+			return []NetworkAdapter{}, err
+		}
+		adapters = append(adapters, adapter)
+	}
+
+	return adapters, nil
+}
+
+// parseNetworkAdapter parses a row of the physical adapter table, whose columns are ordered as in
+// NetworkAdapters, and names its interface from the given interface names keyed by interface index.
+func parseNetworkAdapter(row []string, interfaceNames map[int]string) (NetworkAdapter, error) {
+	index, err := strconv.Atoi(row[0])
+	if err != nil {
+		return NetworkAdapter{}, err
+	}
+	ifNumber := parseIfNumber(row[1])
+	macAddress := net.HardwareAddr(row[2]).String()
+	duplexState := parseDuplexState(row[3])
+	condition := parseStatus(row[4])
+	status := parseNetworkAdapterStatus(row[5])
+	statsValid := row[6] == "1"
+	speed, err := strconv.Atoi(row[7])
+	if err != nil {
+		return NetworkAdapter{}, err
+	}
+	name := prettifyString(row[8])
+	counters := make([]uint32, 0, len(row[9:]))
+	for _, col := range row[9:] {
+		counter, err := strconv.ParseUint(col, 10, 32)
+		if err != nil {
+			return NetworkAdapter{}, err
+		}
+		counters = append(counters, uint32(counter))
+	}
+
+	return NetworkAdapter{
+		ID:            index,
+		IfIndex:       ifNumber,
+		InterfaceName: interfaceNames[ifNumber],
+		Name:          name,
+		MACAddress:    macAddress,
+		SpeedMbps:     speed,
+		DuplexState:   duplexState,
+		Condition:     condition,
+		Status:        status,
+		Statistics: NetworkAdapterStatistics{
+			Valid:                     statsValid,
+			GoodTransmits:             counters[0],
+			GoodReceives:              counters[1],
+			BadTransmits:              counters[2],
+			BadReceives:               counters[3],
+			AlignmentErrors:           counters[4],
+			FCSErrors:                 counters[5],
+			SingleCollisionFrames:     counters[6],
+			MultipleCollisionFrames:   counters[7],
+			DeferredTransmissions:     counters[8],
+			LateCollisions:            counters[9],
+			ExcessiveCollisions:       counters[10],
+			InternalMACTransmitErrors: counters[11],
+			CarrierSenseErrors:        counters[12],
+			FrameTooLongs:             counters[13],
+			InternalMACReceiveErrors:  counters[14],
+			InOctets:                  counters[15],
+			OutOctets:                 counters[16],
+		},
+	}, nil
+}
+
+// interfaceNames returns the name of each interface in the host OS keyed by its MIB-II interface index.
+func (m *MIB) interfaceNames() (map[int]string, error) {
+	columns := OIDList{
+		ifIndex,
+		ifDescr,
+	}
+	table, err := traverseTable(m.snmpClient, columns)
+	if err != nil {
+		return map[int]string{}, err
+	}
+
+	return parseInterfaceNames(table)
+}
+
+// parseInterfaceNames returns the name of each interface keyed by its MIB-II interface index from the
+// rows of the interface table, whose columns are the interface index followed by its description.
+func parseInterfaceNames(table [][]string) (map[int]string, error) {
+	names := map[int]string{}
+	for _, row := range table {
+		index, err := strconv.Atoi(row[0])
+		if err != nil {
+			return map[int]string{}, err
+		}
+		names[index] = prettifyString(row[1])
+	}
+	return names, nil
+}
+
+// Rates computes the per-second rate of each counter between a previous sample and this sample,
+// which were taken elapsed apart. Counters that wrapped around between the samples are accounted for.
+// Returns ErrInvalidSampleInterval if elapsed is not greater than zero.
+func (s *NetworkAdapterStatistics) Rates(previous *NetworkAdapterStatistics, elapsed time.Duration) (NetworkAdapterRates, error) {
+	if elapsed <= 0 {
+		return NetworkAdapterRates{}, ErrInvalidSampleInterval
+	}
+	seconds := elapsed.Seconds()
+	rate := func(previous, current uint32) float64 {
+		return float64(counter32Delta(previous, current)) / seconds
+	}
+	return NetworkAdapterRates{
+		GoodTransmits:             rate(previous.GoodTransmits, s.GoodTransmits),
+		GoodReceives:              rate(previous.GoodReceives, s.GoodReceives),
+		BadTransmits:              rate(previous.BadTransmits, s.BadTransmits),
+		BadReceives:               rate(previous.BadReceives, s.BadReceives),
+		AlignmentErrors:           rate(previous.AlignmentErrors, s.AlignmentErrors),
+		FCSErrors:                 rate(previous.FCSErrors, s.FCSErrors),
+		SingleCollisionFrames:     rate(previous.SingleCollisionFrames, s.SingleCollisionFrames),
+		MultipleCollisionFrames:   rate(previous.MultipleCollisionFrames, s.MultipleCollisionFrames),
+		DeferredTransmissions:     rate(previous.DeferredTransmissions, s.DeferredTransmissions),
+		LateCollisions:            rate(previous.LateCollisions, s.LateCollisions),
+		ExcessiveCollisions:       rate(previous.ExcessiveCollisions, s.ExcessiveCollisions),
+		InternalMACTransmitErrors: rate(previous.InternalMACTransmitErrors, s.InternalMACTransmitErrors),
+		CarrierSenseErrors:        rate(previous.CarrierSenseErrors, s.CarrierSenseErrors),
+		FrameTooLongs:             rate(previous.FrameTooLongs, s.FrameTooLongs),
+		InternalMACReceiveErrors:  rate(previous.InternalMACReceiveErrors, s.InternalMACReceiveErrors),
+		InOctets:                  rate(previous.InOctets, s.InOctets),
+		OutOctets:                 rate(previous.OutOctets, s.OutOctets),
+	}, nil
+}
+
+// parseIfNumber returns the MIB-II interface index encoded in the given little-endian octet string.
+// Returns -1 if the interface index cannot be determined.
+func parseIfNumber(s string) int {
+	if len(s) < 4 {
+		return -1
+	}
+	return int(binary.LittleEndian.Uint32([]byte(s[:4])))
+}
+
+func parseNetworkAdapterStatus(s string) NetworkAdapterStatus {
+	status, ok := networkAdapterStatusIDMappings[s]
+	if !ok {
+		return NetworkAdapterStatusUnknown
+	}
+	return status
+}
+
+// String converts the NetworkAdapterStatus to a human readable string.
+func (n *NetworkAdapterStatus) String() string {
+	s, ok := networkAdapterStatusHumanMappings[*n]
+	if !ok {
+		return "Unknown"
+	}
+	return s
+}
+
+func parseDuplexState(s string) DuplexState {
+	state, ok := duplexStateIDMappings[s]
+	if !ok {
+		return DuplexStateUnknown
+	}
+	return state
+}
+
+// String converts the DuplexState to a human readable string.
+func (d *DuplexState) String() string {
+	s, ok := duplexStateHumanMappings[*d]
+	if !ok {
+		return "Unknown"
+	}
+	return s
+}
@@ -0,0 +1,123 @@
+package hpmib
+
+import (
+	"strconv"
+)
+
+// PhysicalDriveErrorCounter identifies an error counter of a physical drive.
+type PhysicalDriveErrorCounter int
+
+// PhysicalDriveErrors models the error counters of a physical drive in the HP MIB.
+type PhysicalDriveErrors struct {
+	// ControllerID is the ID of the controller the physical drive is attached to, which matches
+	// PhysicalDrive.ControllerID.
+	ControllerID int
+	// DriveID is the ID of the physical drive, which matches PhysicalDrive.ID.
+	DriveID int
+	// Counters contains the value of each error counter supported by the physical drive.
+	// Counters that are not supported by the physical drive are absent.
+	Counters map[PhysicalDriveErrorCounter]int
+}
+
+// Table defined by the HP MIB that contains the error counters of each physical drive.
+const (
+	cpqDaPhyDrvErrCntlrIndex    OID = "1.3.6.1.4.1.232.3.2.6.1.1.1"
+	cpqDaPhyDrvErrIndex         OID = "1.3.6.1.4.1.232.3.2.6.1.1.2"
+	cpqDaPhyDrvErrHardReadErrs  OID = "1.3.6.1.4.1.232.3.2.6.1.1.5"
+	cpqDaPhyDrvErrRecvReadErrs  OID = "1.3.6.1.4.1.232.3.2.6.1.1.6"
+	cpqDaPhyDrvErrHardWriteErrs OID = "1.3.6.1.4.1.232.3.2.6.1.1.7"
+	cpqDaPhyDrvErrRecvWriteErrs OID = "1.3.6.1.4.1.232.3.2.6.1.1.8"
+	cpqDaPhyDrvErrSeekErrs      OID = "1.3.6.1.4.1.232.3.2.6.1.1.10"
+	cpqDaPhyDrvErrDrqTimeouts   OID = "1.3.6.1.4.1.232.3.2.6.1.1.15"
+	cpqDaPhyDrvErrOtherTimeouts OID = "1.3.6.1.4.1.232.3.2.6.1.1.16"
+	cpqDaPhyDrvErrSpinupRetries OID = "1.3.6.1.4.1.232.3.2.6.1.1.17"
+)
+
+// Error counters for physical drives defined by the HP MIB.
+const (
+	PhysicalDriveErrorCounterHardReadErrors PhysicalDriveErrorCounter = iota
+	PhysicalDriveErrorCounterRecoveredReadErrors
+	PhysicalDriveErrorCounterHardWriteErrors
+	PhysicalDriveErrorCounterRecoveredWriteErrors
+	PhysicalDriveErrorCounterSeekErrors
+	PhysicalDriveErrorCounterDRQTimeouts
+	PhysicalDriveErrorCounterOtherTimeouts
+	PhysicalDriveErrorCounterSpinUpRetries
+)
+
+var (
+	physicalDriveErrorCounterHumanMappings = map[PhysicalDriveErrorCounter]string{
+		PhysicalDriveErrorCounterHardReadErrors:       "Hard Read Errors",
+		PhysicalDriveErrorCounterRecoveredReadErrors:  "Recovered Read Errors",
+		PhysicalDriveErrorCounterHardWriteErrors:      "Hard Write Errors",
+		PhysicalDriveErrorCounterRecoveredWriteErrors: "Recovered Write Errors",
+		PhysicalDriveErrorCounterSeekErrors:           "Seek Errors",
+		PhysicalDriveErrorCounterDRQTimeouts:          "DRQ Timeouts",
+		PhysicalDriveErrorCounterOtherTimeouts:        "Other Timeouts",
+		PhysicalDriveErrorCounterSpinUpRetries:        "Spin Up Retries",
+	}
+)
+
+// PhysicalDriveErrors returns the error counters of each physical drive. Returns a non-nil error
+// if the error counters could not be determined.
+func (m *MIB) PhysicalDriveErrors() ([]PhysicalDriveErrors, error) {
+	errs := []PhysicalDriveErrors{}
+
+	columns := OIDList{
+		cpqDaPhyDrvErrCntlrIndex,
+		cpqDaPhyDrvErrIndex,
+		cpqDaPhyDrvErrHardReadErrs,
+		cpqDaPhyDrvErrRecvReadErrs,
+		cpqDaPhyDrvErrHardWriteErrs,
+		cpqDaPhyDrvErrRecvWriteErrs,
+		cpqDaPhyDrvErrSeekErrs,
+		cpqDaPhyDrvErrDrqTimeouts,
+		cpqDaPhyDrvErrOtherTimeouts,
+		cpqDaPhyDrvErrSpinupRetries,
+	}
+	table, err := traverseTable(m.snmpClient, columns)
+	if err != nil {
+		return []PhysicalDriveErrors{}, err
+	}
+
+	for _, row := range table {
+		cntlrIndex, err := strconv.Atoi(row[0])
+		if err != nil {
+			return []PhysicalDriveErrors{}, err
+		}
+		index, err := strconv.Atoi(row[1])
+		if err != nil {
+			return []PhysicalDriveErrors{}, err
+		}
+		// The counters are ordered as the error counter constants. A value of -1 indicates
+		// that the physical drive does not support the counter.
+		counters := map[PhysicalDriveErrorCounter]int{}
+		for i, col := range row[2:] {
+			value, err := strconv.Atoi(col)
+			if err != nil {
+				return []PhysicalDriveErrors{}, err
+			}
+			if value == -1 {
+				continue
+			}
+			counters[PhysicalDriveErrorCounter(i)] = value
+		}
+
+		errs = append(errs, PhysicalDriveErrors{
+			ControllerID: cntlrIndex,
+			DriveID:      index,
+			Counters:     counters,
+		})
+	}
+
+	return errs, nil
+}
+
+// String converts the PhysicalDriveErrorCounter to a human readable string.
+func (p *PhysicalDriveErrorCounter) String() string {
+	s, ok := physicalDriveErrorCounterHumanMappings[*p]
+	if !ok {
+		return "Unknown"
+	}
+	return s
+}
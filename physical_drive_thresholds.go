@@ -0,0 +1,134 @@
+package hpmib
+
+import (
+	"strconv"
+)
+
+// PhysicalDriveThreshold models a monitored parameter of a physical drive in the HP MIB, which the
+// controller compares against a threshold to declare a predictive failure.
+type PhysicalDriveThreshold struct {
+	// ControllerID is the ID of the controller the physical drive is attached to, which matches
+	// PhysicalDrive.ControllerID.
+	ControllerID int
+	// DriveID is the ID of the physical drive, which matches PhysicalDrive.ID. Set to -1 if the
+	// physical drive could not be found.
+	DriveID int
+	// ID is the index of this threshold among the thresholds of the physical drive.
+	ID int
+	// Parameter is the ID of the monitored parameter as reported by the agent.
+	Parameter int
+	// Threshold is the value of the monitored parameter at which a predictive failure is declared.
+	Threshold int
+	// Value is the current value of the monitored parameter.
+	Value int
+	// Exceeded is true if the controller has declared the threshold exceeded.
+	Exceeded bool
+}
+
+// Table defined by the HP MIB that contains the monitored parameters and thresholds of each physical
+// drive. The table numbers the physical drives of each controller from 1 in the order of the physical
+// drive table, rather than by their index. Column 5 is non-zero once the controller declares the
+// threshold exceeded, and columns 7 and 8 hold the threshold and the current value of the parameter.
+// Columns 6 and 9 to 13 are not exposed, as their meaning could not be established from the agents
+// this package is tested against.
+const (
+	cpqDaPhyDrvThrCntlrIndex OID = "1.3.6.1.4.1.232.3.2.8.1.1.1"
+	cpqDaPhyDrvThrDrvIndex   OID = "1.3.6.1.4.1.232.3.2.8.1.1.2"
+	cpqDaPhyDrvThrIndex      OID = "1.3.6.1.4.1.232.3.2.8.1.1.3"
+	cpqDaPhyDrvThrParameter  OID = "1.3.6.1.4.1.232.3.2.8.1.1.4"
+	cpqDaPhyDrvThrExceeded   OID = "1.3.6.1.4.1.232.3.2.8.1.1.5"
+	cpqDaPhyDrvThrThreshold  OID = "1.3.6.1.4.1.232.3.2.8.1.1.7"
+	cpqDaPhyDrvThrValue      OID = "1.3.6.1.4.1.232.3.2.8.1.1.8"
+)
+
+// PhysicalDriveThresholds returns the monitored parameters and thresholds of each physical drive.
+// Returns a non-nil error if the thresholds could not be determined.
+func (m *MIB) PhysicalDriveThresholds() ([]PhysicalDriveThreshold, error) {
+	thresholds := []PhysicalDriveThreshold{}
+
+	columns := OIDList{
+		cpqDaPhyDrvThrCntlrIndex,
+		cpqDaPhyDrvThrDrvIndex,
+		cpqDaPhyDrvThrIndex,
+		cpqDaPhyDrvThrParameter,
+		cpqDaPhyDrvThrExceeded,
+		cpqDaPhyDrvThrThreshold,
+		cpqDaPhyDrvThrValue,
+	}
+	table, err := traverseTable(m.snmpClient, columns)
+	if err != nil {
+		return []PhysicalDriveThreshold{}, err
+	}
+
+	if len(table) == 0 {
+		return thresholds, nil
+	}
+
+	for _, row := range table {
+		threshold, err := parsePhysicalDriveThreshold(row)
+		if err != nil {
+			return []PhysicalDriveThreshold{}, err
+		}
+		thresholds = append(thresholds, threshold)
+	}
+
+	drives, err := m.PhysicalDrives()
+	if err != nil {
+		return []PhysicalDriveThreshold{}, err
+	}
+	resolveThresholdDriveIDs(thresholds, drives)
+
+	return thresholds, nil
+}
+
+// parsePhysicalDriveThreshold parses a row of the threshold table, whose columns are ordered as in
+// PhysicalDriveThresholds. DriveID is set to the number of the physical drive in the table.
+func parsePhysicalDriveThreshold(row []string) (PhysicalDriveThreshold, error) {
+	values := make([]int, 0, len(row))
+	for _, col := range row {
+		value, err := strconv.Atoi(col)
+		if err != nil {
+			return PhysicalDriveThreshold{}, err
+		}
+		values = append(values, value)
+	}
+
+	return PhysicalDriveThreshold{
+		ControllerID: values[0],
+		DriveID:      values[1],
+		ID:           values[2],
+		Parameter:    values[3],
+		Exceeded:     values[4] != 0,
+		Threshold:    values[5],
+		Value:        values[6],
+	}, nil
+}
+
+// resolveThresholdDriveIDs replaces the number of the physical drive of each threshold, which counts
+// the physical drives of its controller from 1, with the ID of that physical drive.
+func resolveThresholdDriveIDs(thresholds []PhysicalDriveThreshold, drives []PhysicalDrive) {
+	ids := map[int][]int{}
+	for _, drive := range drives {
+		ids[drive.ControllerID] = append(ids[drive.ControllerID], drive.ID)
+	}
+	for i, t := range thresholds {
+		n := t.DriveID - 1
+		if n < 0 || n >= len(ids[t.ControllerID]) {
+			thresholds[i].DriveID = -1
+			continue
+		}
+		thresholds[i].DriveID = ids[t.ControllerID][n]
+	}
+}
+
+// ExceededThresholds returns the thresholds that have been exceeded, which explain why a physical
+// drive reports PhysicalDriveStatusPredictiveFailure.
+func ExceededThresholds(thresholds []PhysicalDriveThreshold) []PhysicalDriveThreshold {
+	exceeded := []PhysicalDriveThreshold{}
+	for _, t := range thresholds {
+		if t.Exceeded {
+			exceeded = append(exceeded, t)
+		}
+	}
+	return exceeded
+}
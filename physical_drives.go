@@ -13,6 +13,12 @@ type PhysicalDriveStatus int
 // SMARTStatus describes the state of a physical disk using S.M.A.R.T. semantics.
 type SMARTStatus int
 
+// PhysicalDriveInterfaceType describes the interface a physical drive is attached with.
+type PhysicalDriveInterfaceType int
+
+// RotationalSpeed describes the rotational speed of a physical drive.
+type RotationalSpeed int
+
 // Media types for physical drives defined by the HP MIB.
 const (
 	MediaTypeUnknown         MediaType = -1
@@ -44,6 +50,25 @@ const (
 	SMARTStatusReplaceDrive SMARTStatus = 3
 )
 
+// Interface types for physical drives defined by the HP MIB.
+const (
+	PhysicalDriveInterfaceTypeUnknown      PhysicalDriveInterfaceType = -1
+	PhysicalDriveInterfaceTypeOther        PhysicalDriveInterfaceType = 1
+	PhysicalDriveInterfaceTypeParallelSCSI PhysicalDriveInterfaceType = 2
+	PhysicalDriveInterfaceTypeSATA         PhysicalDriveInterfaceType = 3
+	PhysicalDriveInterfaceTypeSAS          PhysicalDriveInterfaceType = 4
+)
+
+// Rotational speeds for physical drives defined by the HP MIB.
+const (
+	RotationalSpeedUnknown    RotationalSpeed = -1
+	RotationalSpeedOther      RotationalSpeed = 1
+	RotationalSpeed7200RPM    RotationalSpeed = 2
+	RotationalSpeed10000RPM   RotationalSpeed = 3
+	RotationalSpeed15000RPM   RotationalSpeed = 4
+	RotationalSpeedSolidState RotationalSpeed = 5
+)
+
 // Table defined by the HP MIB that contains the status of each physical drive.
 const (
 	cpqDaPhyDrvCntlrIndex  OID = "1.3.6.1.4.1.232.3.2.5.1.1.1"
@@ -57,17 +82,49 @@ const (
 	cpqDaPhyDrvMediaType   OID = "1.3.6.1.4.1.232.3.2.5.1.1.69"
 )
 
-// PhysicalDrive models a physical drive in the HP MIB.
+// Columns of the physical drive table defined by the HP MIB that are not reported by every generation.
+const (
+	cpqDaPhyDrvFWRev                    OID = "1.3.6.1.4.1.232.3.2.5.1.1.4"
+	cpqDaPhyDrvBayLocation              OID = "1.3.6.1.4.1.232.3.2.5.1.1.5"
+	cpqDaPhyDrvRefHours                 OID = "1.3.6.1.4.1.232.3.2.5.1.1.9"
+	cpqDaPhyDrvRotationalSpeed          OID = "1.3.6.1.4.1.232.3.2.5.1.1.59"
+	cpqDaPhyDrvType                     OID = "1.3.6.1.4.1.232.3.2.5.1.1.60"
+	cpqDaPhyDrvHostConnector            OID = "1.3.6.1.4.1.232.3.2.5.1.1.62"
+	cpqDaPhyDrvBoxOnConnector           OID = "1.3.6.1.4.1.232.3.2.5.1.1.63"
+	cpqDaPhyDrvCurrTemperature          OID = "1.3.6.1.4.1.232.3.2.5.1.1.70"
+	cpqDaPhyDrvTemperatureThreshold     OID = "1.3.6.1.4.1.232.3.2.5.1.1.71"
+	cpqDaPhyDrvMaximumTemperature       OID = "1.3.6.1.4.1.232.3.2.5.1.1.72"
+	cpqDaPhyDrvSSDPercntEndrnceUsed     OID = "1.3.6.1.4.1.232.3.2.5.1.1.75"
+	cpqDaPhyDrvSSDEstTimeRemainingHours OID = "1.3.6.1.4.1.232.3.2.5.1.1.76"
+)
+
+// PhysicalDrive models a physical drive in the HP MIB. Integer attributes that are not reported
+// for the physical drive, e.g. because the generation of the server does not support them, are set
+// to -1 and string attributes to an empty string. RefHours is the reference time of the drive, i.e.
+// the number of hours the drive has been spinning since it was stamped, which is not the same as the
+// number of hours it has been powered on.
 type PhysicalDrive struct {
-	ID           int
-	ControllerID int
-	CapacityMB   int
-	MediaType    MediaType
-	Location     string
-	Model        string
-	SerialNo     string
-	SMARTStatus  SMARTStatus
-	Status       PhysicalDriveStatus
+	ID                      int
+	ControllerID            int
+	CapacityMB              int
+	MediaType               MediaType
+	Location                string
+	Model                   string
+	SerialNo                string
+	SMARTStatus             SMARTStatus
+	Status                  PhysicalDriveStatus
+	FirmwareRev             string
+	InterfaceType           PhysicalDriveInterfaceType
+	RotationalSpeed         RotationalSpeed
+	Port                    string
+	Box                     int
+	Bay                     int
+	CurrentTemperatureC     int
+	MaximumTemperatureC     int
+	TemperatureThresholdC   int
+	RefHours                int
+	SSDEnduranceUsedPercent int
+	SSDUsageRemainingHours  int
 }
 
 // PhysicalDrives returns a list of Physical Drives. Returns a non-nil error of the list of Physical
@@ -91,6 +148,28 @@ func (m *MIB) PhysicalDrives() ([]PhysicalDrive, error) {
 		return []PhysicalDrive{}, err
 	}
 
+	// Integer attributes are listed in the order they are assigned to each physical drive.
+	intColumns := OIDList{
+		cpqDaPhyDrvBoxOnConnector,
+		cpqDaPhyDrvBayLocation,
+		cpqDaPhyDrvCurrTemperature,
+		cpqDaPhyDrvMaximumTemperature,
+		cpqDaPhyDrvTemperatureThreshold,
+		cpqDaPhyDrvRefHours,
+		cpqDaPhyDrvSSDPercntEndrnceUsed,
+		cpqDaPhyDrvSSDEstTimeRemainingHours,
+	}
+	optionalColumns := append(OIDList{
+		cpqDaPhyDrvFWRev,
+		cpqDaPhyDrvRotationalSpeed,
+		cpqDaPhyDrvType,
+		cpqDaPhyDrvHostConnector,
+	}, intColumns...)
+	optional, err := traverseOptionalColumns(m.snmpClient, optionalColumns)
+	if err != nil {
+		return []PhysicalDrive{}, err
+	}
+
 	for _, row := range table {
 		cntlrIndex, err := strconv.Atoi(row[0])
 		if err != nil {
@@ -111,16 +190,41 @@ func (m *MIB) PhysicalDrives() ([]PhysicalDrive, error) {
 		location := prettifyString(row[7])
 		mediaType := parseMediaType(row[8])
 
+		key := row[0] + "." + row[1]
+		firmwareRev := prettifyString(optional[cpqDaPhyDrvFWRev][key])
+		interfaceType := parsePhysicalDriveInterfaceType(optional[cpqDaPhyDrvType][key])
+		rotationalSpeed := parseRotationalSpeed(optional[cpqDaPhyDrvRotationalSpeed][key])
+		port := prettifyString(optional[cpqDaPhyDrvHostConnector][key])
+		var ints [8]int
+		for i, column := range intColumns {
+			ints[i], err = parseOptionalInt(optional[column], key)
+			if err != nil {
+				return []PhysicalDrive{}, err
+			}
+		}
+
 		physicalDrives = append(physicalDrives, PhysicalDrive{
-			ControllerID: cntlrIndex,
-			ID:           index,
-			Model:        model,
-			Status:       status,
-			CapacityMB:   size,
-			SerialNo:     serialNo,
-			SMARTStatus:  smartStatus,
-			Location:     location,
-			MediaType:    mediaType,
+			ControllerID:            cntlrIndex,
+			ID:                      index,
+			Model:                   model,
+			Status:                  status,
+			CapacityMB:              size,
+			SerialNo:                serialNo,
+			SMARTStatus:             smartStatus,
+			Location:                location,
+			MediaType:               mediaType,
+			FirmwareRev:             firmwareRev,
+			InterfaceType:           interfaceType,
+			RotationalSpeed:         rotationalSpeed,
+			Port:                    port,
+			Box:                     ints[0],
+			Bay:                     ints[1],
+			CurrentTemperatureC:     ints[2],
+			MaximumTemperatureC:     ints[3],
+			TemperatureThresholdC:   ints[4],
+			RefHours:                ints[5],
+			SSDEnduranceUsedPercent: ints[6],
+			SSDUsageRemainingHours:  ints[7],
 		})
 	}
 
@@ -235,3 +339,69 @@ func (s *SMARTStatus) String() string {
 		return "Unknown"
 	}
 }
+
+func parsePhysicalDriveInterfaceType(s string) PhysicalDriveInterfaceType {
+	switch s {
+	case "1":
+		return PhysicalDriveInterfaceTypeOther
+	case "2":
+		return PhysicalDriveInterfaceTypeParallelSCSI
+	case "3":
+		return PhysicalDriveInterfaceTypeSATA
+	case "4":
+		return PhysicalDriveInterfaceTypeSAS
+	default:
+		return PhysicalDriveInterfaceTypeUnknown
+	}
+}
+
+// String converts the PhysicalDriveInterfaceType to a human readable string.
+func (p *PhysicalDriveInterfaceType) String() string {
+	switch *p {
+	case PhysicalDriveInterfaceTypeOther:
+		return "Other"
+	case PhysicalDriveInterfaceTypeParallelSCSI:
+		return "Parallel SCSI"
+	case PhysicalDriveInterfaceTypeSATA:
+		return "SATA"
+	case PhysicalDriveInterfaceTypeSAS:
+		return "SAS"
+	default:
+		return "Unknown"
+	}
+}
+
+func parseRotationalSpeed(s string) RotationalSpeed {
+	switch s {
+	case "1":
+		return RotationalSpeedOther
+	case "2":
+		return RotationalSpeed7200RPM
+	case "3":
+		return RotationalSpeed10000RPM
+	case "4":
+		return RotationalSpeed15000RPM
+	case "5":
+		return RotationalSpeedSolidState
+	default:
+		return RotationalSpeedUnknown
+	}
+}
+
+// String converts the RotationalSpeed to a human readable string.
+func (r *RotationalSpeed) String() string {
+	switch *r {
+	case RotationalSpeedOther:
+		return "Other"
+	case RotationalSpeed7200RPM:
+		return "7200 RPM"
+	case RotationalSpeed10000RPM:
+		return "10000 RPM"
+	case RotationalSpeed15000RPM:
+		return "15000 RPM"
+	case RotationalSpeedSolidState:
+		return "Solid State"
+	default:
+		return "Unknown"
+	}
+}
@@ -0,0 +1,52 @@
+package hpmib
+
+// PowerMeter models the power meter of the system. The power meter group of the HP MIB only reports
+// the current and previous readings, so peak and average readings are not available. The power
+// regulator mode and the power cap are not reported by the health agents either, and are not part
+// of PowerMeter.
+type PowerMeter struct {
+	// Supported is true if the system reports power meter readings.
+	Supported bool
+	// Status is the status of the power meter.
+	Status Status
+	// CurrentWatts is the most recent power meter reading in Watts. Set to -1 if not reported by the agent.
+	CurrentWatts int
+	// PreviousWatts is the power meter reading preceding CurrentWatts in Watts. Set to -1 if not reported
+	// by the agent.
+	PreviousWatts int
+}
+
+// Scalars defined by the HP MIB that describe the power meter.
+const (
+	cpqHePowerMeterSupported   OID = "1.3.6.1.4.1.232.6.2.15.1"
+	cpqHePowerMeterStatus      OID = "1.3.6.1.4.1.232.6.2.15.2"
+	cpqHePowerMeterPrevReading OID = "1.3.6.1.4.1.232.6.2.15.4"
+)
+
+// PowerMeter returns the readings and status of the power meter. Returns a non-nil error if the
+// power meter could not be queried.
+func (m *MIB) PowerMeter() (PowerMeter, error) {
+	values, err := getScalars(m.snmpClient, OIDList{
+		cpqHePowerMeterSupported,
+		cpqHePowerMeterStatus,
+		cpqHePowerMeterCurrReading,
+		cpqHePowerMeterPrevReading,
+	})
+	if err != nil {
+		return PowerMeter{}, err
+	}
+	current, err := parseScalarInt(values[2])
+	if err != nil {
+		return PowerMeter{}, err
+	}
+	previous, err := parseScalarInt(values[3])
+	if err != nil {
+		return PowerMeter{}, err
+	}
+	return PowerMeter{
+		Supported:     values[0] == "2",
+		Status:        parseStatus(values[1]),
+		CurrentWatts:  current,
+		PreviousWatts: previous,
+	}, nil
+}
@@ -7,7 +7,9 @@ import (
 // PowerSupplyStatus describes the status of a PowerSupply.
 type PowerSupplyStatus int
 
-// PowerSupply models a power supply in the HP MIB.
+// PowerSupply models a power supply in the HP MIB. FirmwareRev, SparePartNo and Location are empty,
+// MainVoltage is set to -1, and Present, Redundant and HotPluggable are false if they are not
+// reported by the agent.
 type PowerSupply struct {
 	BayNo                 int
 	ChassisNo             int
@@ -17,6 +19,13 @@ type PowerSupply struct {
 	Model                 string
 	PowerRatingWatts      int
 	PowerConsumptionWatts int
+	FirmwareRev           string
+	MainVoltage           int
+	Present               bool
+	Redundant             bool
+	HotPluggable          bool
+	SparePartNo           string
+	Location              string
 }
 
 // Statuses for power supplies defined by the HP MIB.
@@ -45,12 +54,19 @@ const (
 const (
 	cpqHeFltTolPowerSupplyChassis         OID = "1.3.6.1.4.1.232.6.2.9.3.1.1"
 	cpqHeFltTolPowerSupplyBay             OID = "1.3.6.1.4.1.232.6.2.9.3.1.2"
+	cpqHeFltTolPowerSupplyPresent         OID = "1.3.6.1.4.1.232.6.2.9.3.1.3"
 	cpqHeFltTolPowerSupplyCondition       OID = "1.3.6.1.4.1.232.6.2.9.3.1.4"
 	cpqHeFltTolPowerSupplyStatus          OID = "1.3.6.1.4.1.232.6.2.9.3.1.5"
+	cpqHeFltTolPowerSupplyMainVoltage     OID = "1.3.6.1.4.1.232.6.2.9.3.1.6"
 	cpqHeFltTolPowerSupplyCapacityUsed    OID = "1.3.6.1.4.1.232.6.2.9.3.1.7"
 	cpqHeFltTolPowerSupplyCapacityMaximum OID = "1.3.6.1.4.1.232.6.2.9.3.1.8"
+	cpqHeFltTolPowerSupplyRedundant       OID = "1.3.6.1.4.1.232.6.2.9.3.1.9"
 	cpqHeFltTolPowerSupplyModel           OID = "1.3.6.1.4.1.232.6.2.9.3.1.10"
 	cpqHeFltTolPowerSupplySerialNumber    OID = "1.3.6.1.4.1.232.6.2.9.3.1.11"
+	cpqHeFltTolPowerSupplyHotPlug         OID = "1.3.6.1.4.1.232.6.2.9.3.1.13"
+	cpqHeFltTolPowerSupplyFirmwareRev     OID = "1.3.6.1.4.1.232.6.2.9.3.1.14"
+	cpqHeFltTolPowerSupplyHwLocation      OID = "1.3.6.1.4.1.232.6.2.9.3.1.15"
+	cpqHeFltTolPowerSupplySparePartNum    OID = "1.3.6.1.4.1.232.6.2.9.3.1.16"
 )
 
 var (
@@ -114,6 +130,20 @@ func (m *MIB) PowerSupplies() ([]PowerSupply, error) {
 		return []PowerSupply{}, err
 	}
 
+	optionalColumns := OIDList{
+		cpqHeFltTolPowerSupplyPresent,
+		cpqHeFltTolPowerSupplyMainVoltage,
+		cpqHeFltTolPowerSupplyRedundant,
+		cpqHeFltTolPowerSupplyHotPlug,
+		cpqHeFltTolPowerSupplyFirmwareRev,
+		cpqHeFltTolPowerSupplySparePartNum,
+		cpqHeFltTolPowerSupplyHwLocation,
+	}
+	optional, err := traverseOptionalColumns(m.snmpClient, optionalColumns)
+	if err != nil {
+		return []PowerSupply{}, err
+	}
+
 	for _, row := range table {
 		chassisNo, err := strconv.Atoi(row[0])
 		if err != nil {
@@ -135,6 +165,17 @@ func (m *MIB) PowerSupplies() ([]PowerSupply, error) {
 		if err != nil {
 			return []PowerSupply{}, err
 		}
+		key := row[0] + "." + row[1]
+		present := optional[cpqHeFltTolPowerSupplyPresent][key] == "3"
+		mainVoltage, err := parseOptionalInt(optional[cpqHeFltTolPowerSupplyMainVoltage], key)
+		if err != nil {
+			return []PowerSupply{}, err
+		}
+		redundant := optional[cpqHeFltTolPowerSupplyRedundant][key] == "3"
+		hotPluggable := optional[cpqHeFltTolPowerSupplyHotPlug][key] == "3"
+		firmwareRev := prettifyString(optional[cpqHeFltTolPowerSupplyFirmwareRev][key])
+		sparePartNo := prettifyString(optional[cpqHeFltTolPowerSupplySparePartNum][key])
+		location := prettifyString(optional[cpqHeFltTolPowerSupplyHwLocation][key])
 
 		powerSupplies = append(powerSupplies, PowerSupply{
 			ChassisNo:             chassisNo,
@@ -145,6 +186,13 @@ func (m *MIB) PowerSupplies() ([]PowerSupply, error) {
 			SerialNo:              serialNo,
 			PowerRatingWatts:      ratingWatts,
 			PowerConsumptionWatts: consumptionWatts,
+			FirmwareRev:           firmwareRev,
+			MainVoltage:           mainVoltage,
+			Present:               present,
+			Redundant:             redundant,
+			HotPluggable:          hotPluggable,
+			SparePartNo:           sparePartNo,
+			Location:              location,
 		})
 	}
 
@@ -0,0 +1,157 @@
+package hpmib
+
+import (
+	"strconv"
+)
+
+// ProcessorUtilization models the utilization of a logical processor reported by the host OS agents in the HP MIB.
+type ProcessorUtilization struct {
+	// ID is the index of this logical processor. An ID of 0 refers to the total across all logical processors.
+	ID int
+	// Name is the name of this logical processor, e.g. "Processor 1", or "Total".
+	Name string
+	// InterruptsPerSec is the number of interrupts serviced by this logical processor per second.
+	InterruptsPerSec int
+	// TimePercent is the percentage of time this logical processor spent executing non-idle threads.
+	TimePercent int
+	// UserTimePercent is the percentage of time this logical processor spent executing in user mode.
+	UserTimePercent int
+	// PrivilegedTimePercent is the percentage of time this logical processor spent executing in privileged mode.
+	PrivilegedTimePercent int
+	// OneMinutePercent is the average utilization of this logical processor over the last minute.
+	// Set to -1 if the average is not available.
+	OneMinutePercent int
+	// FiveMinutePercent is the average utilization of this logical processor over the last five minutes.
+	// Set to -1 if the average is not available.
+	FiveMinutePercent int
+	// ThirtyMinutePercent is the average utilization of this logical processor over the last thirty minutes.
+	// Set to -1 if the average is not available.
+	ThirtyMinutePercent int
+	// OneHourPercent is the average utilization of this logical processor over the last hour.
+	// Set to -1 if the average is not available.
+	OneHourPercent int
+}
+
+// Table defined by the HP MIB that contains the utilization of each logical processor as seen by the host OS.
+const (
+	cpqLinOsCPUIndex                 OID = "1.3.6.1.4.1.232.23.2.3.2.1.1"
+	cpqLinOsCPUInstance              OID = "1.3.6.1.4.1.232.23.2.3.2.1.2"
+	cpqLinOsCPUInterruptsPerSec      OID = "1.3.6.1.4.1.232.23.2.3.2.1.3"
+	cpqLinOsCPUTimePercent           OID = "1.3.6.1.4.1.232.23.2.3.2.1.4"
+	cpqLinOsCPUUserTimePercent       OID = "1.3.6.1.4.1.232.23.2.3.2.1.7"
+	cpqLinOsCPUPrivilegedTimePercent OID = "1.3.6.1.4.1.232.23.2.3.2.1.8"
+)
+
+// Table defined by the HP MIB that contains the average utilization of each logical processor over several windows.
+const (
+	cpqHoCPUUtilUnitIndex OID = "1.3.6.1.4.1.232.11.2.3.1.1.1"
+	cpqHoCPUUtilMin       OID = "1.3.6.1.4.1.232.11.2.3.1.1.2"
+	cpqHoCPUUtilFiveMin   OID = "1.3.6.1.4.1.232.11.2.3.1.1.3"
+	cpqHoCPUUtilThirtyMin OID = "1.3.6.1.4.1.232.11.2.3.1.1.4"
+	cpqHoCPUUtilHour      OID = "1.3.6.1.4.1.232.11.2.3.1.1.5"
+)
+
+// ProcessorUtilization returns the utilization of each logical processor, preceded by the total across
+// all logical processors. Returns a non-nil error if the utilization could not be determined.
+func (m *MIB) ProcessorUtilization() ([]ProcessorUtilization, error) {
+	utilization := []ProcessorUtilization{}
+
+	averages, err := m.processorUtilizationAverages()
+	if err != nil {
+		return []ProcessorUtilization{}, err
+	}
+
+	columns := OIDList{
+		cpqLinOsCPUIndex,
+		cpqLinOsCPUInstance,
+		cpqLinOsCPUInterruptsPerSec,
+		cpqLinOsCPUTimePercent,
+		cpqLinOsCPUUserTimePercent,
+		cpqLinOsCPUPrivilegedTimePercent,
+	}
+	table, err := traverseTable(m.snmpClient, columns)
+	if err != nil {
+		return []ProcessorUtilization{}, err
+	}
+
+	for _, row := range table {
+		index, err := strconv.Atoi(row[0])
+		if err != nil {
+			return []ProcessorUtilization{}, err
+		}
+		name := prettifyString(row[1])
+		interrupts, err := strconv.Atoi(row[2])
+		if err != nil {
+			return []ProcessorUtilization{}, err
+		}
+		timePercent, err := strconv.Atoi(row[3])
+		if err != nil {
+			return []ProcessorUtilization{}, err
+		}
+		userTimePercent, err := strconv.Atoi(row[4])
+		if err != nil {
+			return []ProcessorUtilization{}, err
+		}
+		privilegedTimePercent, err := strconv.Atoi(row[5])
+		if err != nil {
+			return []ProcessorUtilization{}, err
+		}
+
+		// The host OS numbers its logical processors starting from 1, whereas the averages
+		// are indexed starting from 0. Index 0 is the total, for which no averages exist.
+		avg, ok := averages[index-1]
+		if index == 0 || !ok {
+			avg = [4]int{-1, -1, -1, -1}
+		}
+
+		utilization = append(utilization, ProcessorUtilization{
+			ID:                    index,
+			Name:                  name,
+			InterruptsPerSec:      interrupts,
+			TimePercent:           timePercent,
+			UserTimePercent:       userTimePercent,
+			PrivilegedTimePercent: privilegedTimePercent,
+			OneMinutePercent:      avg[0],
+			FiveMinutePercent:     avg[1],
+			ThirtyMinutePercent:   avg[2],
+			OneHourPercent:        avg[3],
+		})
+	}
+
+	return utilization, nil
+}
+
+// processorUtilizationAverages returns the one, five, thirty and sixty minute utilization averages of
+// each logical processor keyed by the index of the logical processor.
+func (m *MIB) processorUtilizationAverages() (map[int][4]int, error) {
+	averages := map[int][4]int{}
+
+	columns := OIDList{
+		cpqHoCPUUtilUnitIndex,
+		cpqHoCPUUtilMin,
+		cpqHoCPUUtilFiveMin,
+		cpqHoCPUUtilThirtyMin,
+		cpqHoCPUUtilHour,
+	}
+	table, err := traverseTable(m.snmpClient, columns)
+	if err != nil {
+		return averages, err
+	}
+
+	for _, row := range table {
+		index, err := strconv.Atoi(row[0])
+		if err != nil {
+			return averages, err
+		}
+		var avg [4]int
+		for i, col := range row[1:] {
+			avg[i], err = strconv.Atoi(col)
+			if err != nil {
+				return averages, err
+			}
+		}
+		averages[index] = avg
+	}
+
+	return averages, nil
+}
@@ -27,7 +27,10 @@ const (
 	ProcessorPowerStatusHighPowered   ProcessorPowerStatus = 4
 )
 
-// Processor models a CPU in the HP MIB.
+// Processor models a CPU in the HP MIB. Socket and Stepping are set to -1 and Family is empty if
+// they are not reported by the agent. PhysicalCores is the number of cores of the processor; the
+// agent does not report how many of them are enabled, so no separate enabled core count is exposed.
+// Family is the processor family, e.g. "Intel Xeon".
 type Processor struct {
 	ID                  int
 	Name                string
@@ -37,6 +40,17 @@ type Processor struct {
 	VirtualCores        int
 	Status              ProcessorStatus
 	PowerStatus         ProcessorPowerStatus
+	Socket              int
+	Stepping            int
+	Family              string
+	Caches              []ProcessorCache
+}
+
+// ProcessorCache models a cache of a CPU in the HP MIB.
+type ProcessorCache struct {
+	Level  int
+	SizeKB int
+	Status ProcessorStatus
 }
 
 // Table defined by the HP MIB that contains the status of each processor.
@@ -44,13 +58,24 @@ const (
 	cpqSeCPUUnitIndex      OID = "1.3.6.1.4.1.232.1.2.2.1.1.1"
 	cpqSeCPUName           OID = "1.3.6.1.4.1.232.1.2.2.1.1.3"
 	cpqSeCPUSpeed          OID = "1.3.6.1.4.1.232.1.2.2.1.1.4"
+	cpqSeCPUStep           OID = "1.3.6.1.4.1.232.1.2.2.1.1.5"
 	cpqSeCPUStatus         OID = "1.3.6.1.4.1.232.1.2.2.1.1.6"
+	cpqSeCPUSocketNumber   OID = "1.3.6.1.4.1.232.1.2.2.1.1.9"
 	cpqSeCPUCore           OID = "1.3.6.1.4.1.232.1.2.2.1.1.15"
 	cpqSeCPUMaxSpeed       OID = "1.3.6.1.4.1.232.1.2.2.1.1.21"
+	cpqSeCPUFamily         OID = "1.3.6.1.4.1.232.1.2.2.1.1.23"
 	cpqSeCPUCoreMaxThreads OID = "1.3.6.1.4.1.232.1.2.2.1.1.25"
 	cpqSeCPULowPowerStatus OID = "1.3.6.1.4.1.232.1.2.2.1.1.26"
 )
 
+// Table defined by the HP MIB that contains the size and status of each processor cache.
+const (
+	cpqSeCPUCacheUnitIndex  OID = "1.3.6.1.4.1.232.1.2.2.3.1.1"
+	cpqSeCPUCacheLevelIndex OID = "1.3.6.1.4.1.232.1.2.2.3.1.2"
+	cpqSeCPUCacheSize       OID = "1.3.6.1.4.1.232.1.2.2.3.1.3"
+	cpqSeCPUCacheStatus     OID = "1.3.6.1.4.1.232.1.2.2.3.1.6"
+)
+
 // Processors returns a list of Processors. Returns a non-nil error if the list of Processors
 // could not be determined.
 func (m *MIB) Processors() ([]Processor, error) {
@@ -71,6 +96,21 @@ func (m *MIB) Processors() ([]Processor, error) {
 		return []Processor{}, err
 	}
 
+	optionalColumns := OIDList{
+		cpqSeCPUStep,
+		cpqSeCPUSocketNumber,
+		cpqSeCPUFamily,
+	}
+	optional, err := traverseOptionalColumns(m.snmpClient, optionalColumns)
+	if err != nil {
+		return []Processor{}, err
+	}
+
+	caches, err := m.processorCaches()
+	if err != nil {
+		return []Processor{}, err
+	}
+
 	for _, row := range table {
 		id, err := strconv.Atoi(row[0])
 		if err != nil {
@@ -95,6 +135,19 @@ func (m *MIB) Processors() ([]Processor, error) {
 			return []Processor{}, nil
 		}
 		powerStatus := parseProcessorPowerStatus(row[7])
+		stepping, err := parseOptionalInt(optional[cpqSeCPUStep], row[0])
+		if err != nil {
+			return []Processor{}, err
+		}
+		socket, err := parseOptionalInt(optional[cpqSeCPUSocketNumber], row[0])
+		if err != nil {
+			return []Processor{}, err
+		}
+		family := prettifyString(optional[cpqSeCPUFamily][row[0]])
+		processorCaches, ok := caches[id]
+		if !ok {
+			processorCaches = []ProcessorCache{}
+		}
 
 		processors = append(processors, Processor{
 			ID:                  id,
@@ -105,12 +158,56 @@ func (m *MIB) Processors() ([]Processor, error) {
 			MaxClockSpeedHz:     maxSpeed,
 			VirtualCores:        virtualCores,
 			PowerStatus:         powerStatus,
+			Socket:              socket,
+			Stepping:            stepping,
+			Family:              family,
+			Caches:              processorCaches,
 		})
 	}
 
 	return processors, nil
 }
 
+// processorCaches returns the caches of each processor keyed by the ID of the processor.
+func (m *MIB) processorCaches() (map[int][]ProcessorCache, error) {
+	caches := map[int][]ProcessorCache{}
+
+	columns := OIDList{
+		cpqSeCPUCacheUnitIndex,
+		cpqSeCPUCacheLevelIndex,
+		cpqSeCPUCacheSize,
+		cpqSeCPUCacheStatus,
+	}
+	table, err := traverseTable(m.snmpClient, columns)
+	if err != nil {
+		return map[int][]ProcessorCache{}, err
+	}
+
+	for _, row := range table {
+		unit, err := strconv.Atoi(row[0])
+		if err != nil {
+			return map[int][]ProcessorCache{}, err
+		}
+		level, err := strconv.Atoi(row[1])
+		if err != nil {
+			return map[int][]ProcessorCache{}, err
+		}
+		size, err := strconv.Atoi(row[2])
+		if err != nil {
+			return map[int][]ProcessorCache{}, err
+		}
+		status := parseProcessorStatus(row[3])
+
+		caches[unit] = append(caches[unit], ProcessorCache{
+			Level:  level,
+			SizeKB: size,
+			Status: status,
+		})
+	}
+
+	return caches, nil
+}
+
 func parseProcessorStatus(s string) ProcessorStatus {
 	switch s {
 	case "2":
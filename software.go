@@ -0,0 +1,176 @@
+package hpmib
+
+import (
+	"time"
+)
+
+// SoftwareType describes the kind of an installed software component.
+type SoftwareType int
+
+// SoftwareStatus describes whether an installed software component is loaded.
+type SoftwareStatus int
+
+// Software models an installed HP software component, driver or agent in the HP MIB.
+type Software struct {
+	// ID is the position of this software component in the software inventory.
+	ID int
+	// Name is the name of this software component, e.g. "hp-snmp-agents_x86_64".
+	Name string
+	// Description describes this software component, e.g. "HP Diagnostics Utility".
+	Description string
+	// Type is the kind of this software component.
+	Type SoftwareType
+	// Status indicates whether this software component is loaded.
+	Status SoftwareStatus
+	// Version is the version of this software component, e.g. "10.50-2926.39.rhel6".
+	Version string
+	// InstallDate is the date this software component was installed. Set to the zero time if
+	// the install date is not reported.
+	InstallDate time.Time
+}
+
+// Table defined by the HP MIB that contains the version of each installed software component.
+const (
+	cpqHoSwVerStatus      OID = "1.3.6.1.4.1.232.11.2.7.2.1.2"
+	cpqHoSwVerType        OID = "1.3.6.1.4.1.232.11.2.7.2.1.3"
+	cpqHoSwVerName        OID = "1.3.6.1.4.1.232.11.2.7.2.1.4"
+	cpqHoSwVerDescription OID = "1.3.6.1.4.1.232.11.2.7.2.1.5"
+	cpqHoSwVerDate        OID = "1.3.6.1.4.1.232.11.2.7.2.1.6"
+	cpqHoSwVerVersion     OID = "1.3.6.1.4.1.232.11.2.7.2.1.8"
+)
+
+// Software types defined by the HP MIB.
+const (
+	SoftwareTypeUnknown     SoftwareType = -1
+	SoftwareTypeOther       SoftwareType = 1
+	SoftwareTypeDriver      SoftwareType = 2
+	SoftwareTypeAgent       SoftwareType = 3
+	SoftwareTypeSysUtil     SoftwareType = 4
+	SoftwareTypeApplication SoftwareType = 5
+)
+
+// Software statuses defined by the HP MIB.
+const (
+	SoftwareStatusUnknown   SoftwareStatus = -1
+	SoftwareStatusOther     SoftwareStatus = 1
+	SoftwareStatusLoaded    SoftwareStatus = 2
+	SoftwareStatusNotLoaded SoftwareStatus = 3
+)
+
+var (
+	softwareTypeIDMappings = map[string]SoftwareType{
+		"1": SoftwareTypeOther,
+		"2": SoftwareTypeDriver,
+		"3": SoftwareTypeAgent,
+		"4": SoftwareTypeSysUtil,
+		"5": SoftwareTypeApplication,
+	}
+	softwareTypeHumanMappings = map[SoftwareType]string{
+		SoftwareTypeOther:       "Other",
+		SoftwareTypeDriver:      "Driver",
+		SoftwareTypeAgent:       "Agent",
+		SoftwareTypeSysUtil:     "System Utility",
+		SoftwareTypeApplication: "Application",
+	}
+	softwareStatusIDMappings = map[string]SoftwareStatus{
+		"1": SoftwareStatusOther,
+		"2": SoftwareStatusLoaded,
+		"3": SoftwareStatusNotLoaded,
+	}
+	softwareStatusHumanMappings = map[SoftwareStatus]string{
+		SoftwareStatusOther:     "Other",
+		SoftwareStatusLoaded:    "Loaded",
+		SoftwareStatusNotLoaded: "Not Loaded",
+	}
+)
+
+// Software returns the installed HP software components, drivers and agents. Returns a non-nil
+// error if the software inventory could not be determined.
+func (m *MIB) Software() ([]Software, error) {
+	software := []Software{}
+
+	columns := OIDList{
+		cpqHoSwVerStatus,
+		cpqHoSwVerType,
+		cpqHoSwVerName,
+		cpqHoSwVerDescription,
+		cpqHoSwVerDate,
+		cpqHoSwVerVersion,
+	}
+	table, err := traverseTable(m.snmpClient, columns)
+	if err != nil {
+		return []Software{}, err
+	}
+
+	// The agent may report the same value in the index column of several entries, so the
+	// position of each entry is used as its ID instead.
+	for i, row := range table {
+		status := parseSoftwareStatus(row[0])
+		softwareType := parseSoftwareType(row[1])
+		name := prettifyString(row[2])
+		description := prettifyString(row[3])
+		installDate := parseSoftwareDate(row[4])
+		version := prettifyString(row[5])
+
+		software = append(software, Software{
+			ID:          i,
+			Name:        name,
+			Description: description,
+			Type:        softwareType,
+			Status:      status,
+			Version:     version,
+			InstallDate: installDate,
+		})
+	}
+
+	return software, nil
+}
+
+// parseSoftwareDate decodes the install date of a software component, which is encoded as a
+// two octet year followed by one octet each for the month, day, hour, minute and second.
+// Returns the zero time if the install date is not reported.
+func parseSoftwareDate(s string) time.Time {
+	b := []byte(s)
+	if len(b) < 7 {
+		return time.Time{}
+	}
+	year := int(b[0])<<8 | int(b[1])
+	if year == 0 {
+		return time.Time{}
+	}
+	return time.Date(year, time.Month(b[2]), int(b[3]), int(b[4]), int(b[5]), int(b[6]), 0, time.UTC)
+}
+
+func parseSoftwareType(s string) SoftwareType {
+	softwareType, ok := softwareTypeIDMappings[s]
+	if !ok {
+		return SoftwareTypeUnknown
+	}
+	return softwareType
+}
+
+// String converts the SoftwareType to a human readable string.
+func (s *SoftwareType) String() string {
+	str, ok := softwareTypeHumanMappings[*s]
+	if !ok {
+		return "Unknown"
+	}
+	return str
+}
+
+func parseSoftwareStatus(s string) SoftwareStatus {
+	status, ok := softwareStatusIDMappings[s]
+	if !ok {
+		return SoftwareStatusUnknown
+	}
+	return status
+}
+
+// String converts the SoftwareStatus to a human readable string.
+func (s *SoftwareStatus) String() string {
+	str, ok := softwareStatusHumanMappings[*s]
+	if !ok {
+		return "Unknown"
+	}
+	return str
+}
@@ -0,0 +1,214 @@
+package hpmib
+
+import (
+	"strconv"
+)
+
+// SpareDriveStatus describes the state of a spare drive.
+type SpareDriveStatus int
+
+// SpareDrive models a physical drive that is configured as an online spare.
+type SpareDrive struct {
+	// ControllerID is the index of the controller this spare drive is attached to.
+	ControllerID int
+	// PhysicalDriveID is the ID of the PhysicalDrive on the same controller that is configured as this spare.
+	PhysicalDriveID int
+	// Status is the state of this spare drive.
+	Status SpareDriveStatus
+	// Condition is the overall condition of this spare drive. Set to StatusUnknown if not reported by the agent.
+	Condition Status
+	// AssignedLogicalDriveIDs contains the IDs of the logical drives on the same controller that list this
+	// spare drive among their available spares.
+	AssignedLogicalDriveIDs []int
+	// LogicalDriveID is the ID of the logical drive this spare drive is replacing a failed physical drive in.
+	// Set to -1 if this spare drive is not replacing a physical drive.
+	LogicalDriveID int
+	// ReplacedDriveID is the ID of the failed physical drive this spare drive is replacing.
+	// Set to -1 if this spare drive is not replacing a physical drive.
+	ReplacedDriveID int
+	// PercentRebuild is the percentage of the rebuild onto this spare drive that has completed.
+	// Set to -1 if this spare drive is not building or if not reported by the agent.
+	PercentRebuild int
+}
+
+// Table defined by the HP MIB that contains the properties of each spare drive. The replaced drive
+// column is only valid while the spare is building or active, and the rebuild percentage only while
+// it is building.
+const (
+	cpqDaSpareCntlrIndex     OID = "1.3.6.1.4.1.232.3.2.4.1.1.1"
+	cpqDaSparePhyDrvIndex    OID = "1.3.6.1.4.1.232.3.2.4.1.1.2"
+	cpqDaSpareStatus         OID = "1.3.6.1.4.1.232.3.2.4.1.1.3"
+	cpqDaSpareReplacedDrv    OID = "1.3.6.1.4.1.232.3.2.4.1.1.4"
+	cpqDaSpareCondition      OID = "1.3.6.1.4.1.232.3.2.4.1.1.6"
+	cpqDaSparePercentRebuild OID = "1.3.6.1.4.1.232.3.2.4.1.1.11"
+)
+
+// Spare drive states defined by the HP MIB.
+const (
+	SpareDriveStatusUnknown  SpareDriveStatus = -1
+	SpareDriveStatusOther    SpareDriveStatus = 1
+	SpareDriveStatusInvalid  SpareDriveStatus = 2
+	SpareDriveStatusFailed   SpareDriveStatus = 3
+	SpareDriveStatusInactive SpareDriveStatus = 4
+	SpareDriveStatusBuilding SpareDriveStatus = 5
+	SpareDriveStatusActive   SpareDriveStatus = 6
+)
+
+var (
+	spareDriveStatusIDMappings = map[string]SpareDriveStatus{
+		"1": SpareDriveStatusOther,
+		"2": SpareDriveStatusInvalid,
+		"3": SpareDriveStatusFailed,
+		"4": SpareDriveStatusInactive,
+		"5": SpareDriveStatusBuilding,
+		"6": SpareDriveStatusActive,
+	}
+	spareDriveStatusHumanMappings = map[SpareDriveStatus]string{
+		SpareDriveStatusOther:    "Other",
+		SpareDriveStatusInvalid:  "Invalid",
+		SpareDriveStatusFailed:   "Failed",
+		SpareDriveStatusInactive: "Inactive",
+		SpareDriveStatusBuilding: "Building",
+		SpareDriveStatusActive:   "Active",
+	}
+)
+
+// SpareDrives returns a list of spare drives. Returns a non-nil error if the list of spare drives
+// could not be determined.
+func (m *MIB) SpareDrives() ([]SpareDrive, error) {
+	columns := OIDList{
+		cpqDaSpareCntlrIndex,
+		cpqDaSparePhyDrvIndex,
+		cpqDaSpareStatus,
+	}
+	table, err := traverseTable(m.snmpClient, columns)
+	if err != nil {
+		return []SpareDrive{}, err
+	}
+	if len(table) == 0 {
+		return []SpareDrive{}, nil
+	}
+
+	optionalColumns := OIDList{
+		cpqDaSpareReplacedDrv,
+		cpqDaSpareCondition,
+		cpqDaSparePercentRebuild,
+	}
+	optional, err := traverseOptionalColumns(m.snmpClient, optionalColumns)
+	if err != nil {
+		return []SpareDrive{}, err
+	}
+
+	// The spare table does not identify the logical drive a spare is assigned to or replacing a
+	// physical drive in, so it is looked up in the logical drives instead.
+	logicalDrives, err := m.LogicalDrives()
+	if err != nil {
+		return []SpareDrive{}, err
+	}
+
+	return parseSpareDrives(table, optional, logicalDrives)
+}
+
+// parseSpareDrives builds the spare drives from the rows of the spare table, whose columns are ordered
+// as in SpareDrives, the optional columns of the table and the logical drives of the system.
+func parseSpareDrives(table [][]string, optional map[OID]map[string]string, logicalDrives []LogicalDrive) ([]SpareDrive, error) {
+	spareDrives := []SpareDrive{}
+
+	for _, row := range table {
+		cntlrIndex, err := strconv.Atoi(row[0])
+		if err != nil {
+			return []SpareDrive{}, err
+		}
+		index, err := strconv.Atoi(row[1])
+		if err != nil {
+			return []SpareDrive{}, err
+		}
+		status := parseSpareDriveStatus(row[2])
+
+		assigned := []int{}
+		logicalDriveID, replacedDriveID := -1, -1
+		for _, logicalDrive := range logicalDrives {
+			if logicalDrive.ControllerID != cntlrIndex {
+				continue
+			}
+			for _, spare := range logicalDrive.AvailableSpares {
+				if spare == index {
+					assigned = append(assigned, logicalDrive.ID)
+				}
+			}
+			for failed, spare := range logicalDrive.SpareReplacements {
+				if spare == index {
+					logicalDriveID, replacedDriveID = logicalDrive.ID, failed
+				}
+			}
+		}
+
+		key := row[0] + "." + row[1]
+		percentRebuild := -1
+		switch status {
+		case SpareDriveStatusBuilding:
+			percentRebuild, err = parseOptionalInt(optional[cpqDaSparePercentRebuild], key)
+			if err != nil {
+				return []SpareDrive{}, err
+			}
+			fallthrough
+		case SpareDriveStatusActive:
+			replaced, err := parseOptionalInt(optional[cpqDaSpareReplacedDrv], key)
+			if err != nil {
+				return []SpareDrive{}, err
+			}
+			if replaced != -1 {
+				replacedDriveID = replaced
+			}
+		}
+		if logicalDriveID == -1 && replacedDriveID != -1 {
+			logicalDriveID = findLogicalDriveID(logicalDrives, cntlrIndex, replacedDriveID)
+		}
+
+		spareDrives = append(spareDrives, SpareDrive{
+			ControllerID:            cntlrIndex,
+			PhysicalDriveID:         index,
+			Status:                  status,
+			Condition:               parseStatus(optional[cpqDaSpareCondition][key]),
+			AssignedLogicalDriveIDs: assigned,
+			LogicalDriveID:          logicalDriveID,
+			ReplacedDriveID:         replacedDriveID,
+			PercentRebuild:          percentRebuild,
+		})
+	}
+
+	return spareDrives, nil
+}
+
+// findLogicalDriveID returns the ID of the logical drive on the given controller that the given physical
+// drive is a member of. Returns -1 if the physical drive is not a member of any logical drive.
+func findLogicalDriveID(logicalDrives []LogicalDrive, cntlrIndex, driveID int) int {
+	for _, logicalDrive := range logicalDrives {
+		if logicalDrive.ControllerID != cntlrIndex {
+			continue
+		}
+		for _, id := range logicalDrive.PhysicalDriveIDs {
+			if id == driveID {
+				return logicalDrive.ID
+			}
+		}
+	}
+	return -1
+}
+
+func parseSpareDriveStatus(s string) SpareDriveStatus {
+	status, ok := spareDriveStatusIDMappings[s]
+	if !ok {
+		return SpareDriveStatusUnknown
+	}
+	return status
+}
+
+// String converts the SpareDriveStatus to a human readable string.
+func (s *SpareDriveStatus) String() string {
+	str, ok := spareDriveStatusHumanMappings[*s]
+	if !ok {
+		return "Unknown"
+	}
+	return str
+}
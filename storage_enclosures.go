@@ -0,0 +1,187 @@
+package hpmib
+
+import (
+	"strconv"
+)
+
+// EnclosureComponentStatus describes the state of the fans, temperature sensors or power supplies of a
+// storage enclosure.
+type EnclosureComponentStatus int
+
+// StorageEnclosure models a storage enclosure or drive backplane in the HP MIB.
+type StorageEnclosure struct {
+	// ID is the index of this storage enclosure on its controller.
+	ID int
+	// ControllerID is the ID of the controller this storage enclosure is attached to, which matches
+	// Controller.ID.
+	ControllerID int
+	// Name is the model name of this storage enclosure, e.g. "Gen8 ServBP 25+2".
+	Name string
+	// Vendor is the vendor of this storage enclosure.
+	Vendor string
+	// FirmwareRev is the firmware revision of this storage enclosure.
+	FirmwareRev string
+	// SerialNo is the serial number of this storage enclosure.
+	SerialNo string
+	// Location describes where this storage enclosure is connected to its controller, e.g. "Port 1I Box 1".
+	Location string
+	// ControllerLocation is the hardware location of the controller, e.g. "Slot 0".
+	ControllerLocation string
+	// TotalBays is the number of drive bays in this storage enclosure.
+	TotalBays int
+	// Condition is the overall condition of this storage enclosure.
+	Condition Status
+	// FanStatus is the status of the fans of this storage enclosure.
+	FanStatus EnclosureComponentStatus
+	// TemperatureStatus is the status of the temperature sensors of this storage enclosure.
+	TemperatureStatus EnclosureComponentStatus
+	// PowerSupplyStatus is the status of the fault tolerant power supplies of this storage enclosure.
+	PowerSupplyStatus EnclosureComponentStatus
+}
+
+// Table defined by the HP MIB that contains the status of each storage enclosure.
+const (
+	cpqSsBoxCntlrIndex            OID = "1.3.6.1.4.1.232.8.2.1.1.1"
+	cpqSsBoxBusIndex              OID = "1.3.6.1.4.1.232.8.2.1.1.2"
+	cpqSsBoxModel                 OID = "1.3.6.1.4.1.232.8.2.1.1.4"
+	cpqSsBoxFWRev                 OID = "1.3.6.1.4.1.232.8.2.1.1.5"
+	cpqSsBoxVendor                OID = "1.3.6.1.4.1.232.8.2.1.1.6"
+	cpqSsBoxFanStatus             OID = "1.3.6.1.4.1.232.8.2.1.1.7"
+	cpqSsBoxCondition             OID = "1.3.6.1.4.1.232.8.2.1.1.8"
+	cpqSsBoxTempStatus            OID = "1.3.6.1.4.1.232.8.2.1.1.9"
+	cpqSsBoxFltTolPwrSupplyStatus OID = "1.3.6.1.4.1.232.8.2.1.1.11"
+	cpqSsBoxTotalBays             OID = "1.3.6.1.4.1.232.8.2.1.1.13"
+	cpqSsBoxSerialNumber          OID = "1.3.6.1.4.1.232.8.2.1.1.17"
+	cpqSsBoxCntlrHwLocation       OID = "1.3.6.1.4.1.232.8.2.1.1.18"
+	cpqSsBoxLocationString        OID = "1.3.6.1.4.1.232.8.2.1.1.23"
+)
+
+// Statuses for the components of a storage enclosure defined by the HP MIB.
+const (
+	EnclosureComponentStatusUnknown    EnclosureComponentStatus = -1
+	EnclosureComponentStatusOther      EnclosureComponentStatus = 1
+	EnclosureComponentStatusOK         EnclosureComponentStatus = 2
+	EnclosureComponentStatusDegraded   EnclosureComponentStatus = 3
+	EnclosureComponentStatusFailed     EnclosureComponentStatus = 4
+	EnclosureComponentStatusNotPresent EnclosureComponentStatus = 5
+)
+
+var (
+	// The fan status of a storage enclosure uses a different encoding than the temperature and
+	// power supply statuses.
+	enclosureFanStatusIDMappings = map[string]EnclosureComponentStatus{
+		"1": EnclosureComponentStatusOther,
+		"2": EnclosureComponentStatusOK,
+		"3": EnclosureComponentStatusFailed,
+		"4": EnclosureComponentStatusNotPresent,
+		"5": EnclosureComponentStatusDegraded,
+	}
+	enclosureComponentStatusIDMappings = map[string]EnclosureComponentStatus{
+		"1": EnclosureComponentStatusOther,
+		"2": EnclosureComponentStatusOK,
+		"3": EnclosureComponentStatusDegraded,
+		"4": EnclosureComponentStatusFailed,
+		"5": EnclosureComponentStatusNotPresent,
+	}
+	enclosureComponentStatusHumanMappings = map[EnclosureComponentStatus]string{
+		EnclosureComponentStatusOther:      "Other",
+		EnclosureComponentStatusOK:         "OK",
+		EnclosureComponentStatusDegraded:   "Degraded",
+		EnclosureComponentStatusFailed:     "Failed",
+		EnclosureComponentStatusNotPresent: "Not Present",
+	}
+)
+
+// StorageEnclosures returns a list of StorageEnclosures. Returns a non-nil error if the list of
+// StorageEnclosures could not be determined.
+func (m *MIB) StorageEnclosures() ([]StorageEnclosure, error) {
+	enclosures := []StorageEnclosure{}
+
+	columns := OIDList{
+		cpqSsBoxCntlrIndex,
+		cpqSsBoxBusIndex,
+		cpqSsBoxModel,
+		cpqSsBoxFWRev,
+		cpqSsBoxVendor,
+		cpqSsBoxFanStatus,
+		cpqSsBoxCondition,
+		cpqSsBoxTempStatus,
+		cpqSsBoxFltTolPwrSupplyStatus,
+		cpqSsBoxTotalBays,
+		cpqSsBoxSerialNumber,
+		cpqSsBoxCntlrHwLocation,
+		cpqSsBoxLocationString,
+	}
+	table, err := traverseTable(m.snmpClient, columns)
+	if err != nil {
+		return []StorageEnclosure{}, err
+	}
+
+	for _, row := range table {
+		cntlrIndex, err := strconv.Atoi(row[0])
+		if err != nil {
+			return []StorageEnclosure{}, err
+		}
+		index, err := strconv.Atoi(row[1])
+		if err != nil {
+			return []StorageEnclosure{}, err
+		}
+		name := prettifyString(row[2])
+		firmwareRev := prettifyString(row[3])
+		vendor := prettifyString(row[4])
+		fanStatus := parseEnclosureFanStatus(row[5])
+		condition := parseStatus(row[6])
+		tempStatus := parseEnclosureComponentStatus(row[7])
+		powerSupplyStatus := parseEnclosureComponentStatus(row[8])
+		totalBays, err := strconv.Atoi(row[9])
+		if err != nil {
+			return []StorageEnclosure{}, err
+		}
+		serialNo := prettifyString(row[10])
+		cntlrLocation := prettifyString(row[11])
+		location := prettifyString(row[12])
+
+		enclosures = append(enclosures, StorageEnclosure{
+			ID:                 index,
+			ControllerID:       cntlrIndex,
+			Name:               name,
+			Vendor:             vendor,
+			FirmwareRev:        firmwareRev,
+			SerialNo:           serialNo,
+			Location:           location,
+			ControllerLocation: cntlrLocation,
+			TotalBays:          totalBays,
+			Condition:          condition,
+			FanStatus:          fanStatus,
+			TemperatureStatus:  tempStatus,
+			PowerSupplyStatus:  powerSupplyStatus,
+		})
+	}
+
+	return enclosures, nil
+}
+
+func parseEnclosureFanStatus(s string) EnclosureComponentStatus {
+	status, ok := enclosureFanStatusIDMappings[s]
+	if !ok {
+		return EnclosureComponentStatusUnknown
+	}
+	return status
+}
+
+func parseEnclosureComponentStatus(s string) EnclosureComponentStatus {
+	status, ok := enclosureComponentStatusIDMappings[s]
+	if !ok {
+		return EnclosureComponentStatusUnknown
+	}
+	return status
+}
+
+// String converts the EnclosureComponentStatus to a human readable string.
+func (e *EnclosureComponentStatus) String() string {
+	s, ok := enclosureComponentStatusHumanMappings[*e]
+	if !ok {
+		return "Unknown"
+	}
+	return s
+}
@@ -0,0 +1,114 @@
+package hpmib
+
+import (
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// SystemInfo models the asset information of the server. The serial number, model and UUID are read
+// the same way as for SystemIdentity, so that the asset information can be keyed on them without a
+// second call.
+type SystemInfo struct {
+	// SerialNumber is the serial number of the server, which also identifies its chassis.
+	SerialNumber string
+	// Model is the model of the server, e.g. "ProLiant DL380 G7".
+	Model string
+	// ProductID is the product ID of the server, also known as its SKU, e.g. "583966-421".
+	// Empty if not reported by the agent.
+	ProductID string
+	// AssetTag is the asset tag assigned to the server. Empty if no asset tag is assigned.
+	AssetTag string
+	// UUID is the globally unique identifier of the server in its canonical form, e.g.
+	// "35363037-3933-5355-4533-31363239444E". Empty if not reported by the agent.
+	UUID string
+	// FormFactor is the form factor of the chassis as reported by the agent. The values are not
+	// translated, as their meaning could not be confirmed. Set to -1 if not reported by the agent.
+	FormFactor int
+	// SystemID is the identifier of the system board, e.g. "CPQ07AD". Empty if not reported by the agent.
+	SystemID string
+	// ROMVersion is the version of the system ROM, e.g. "05/05/2011, Family P67".
+	ROMVersion string
+	// ROMFamily is the family of the system ROM, e.g. "P67". Empty if the version does not contain it.
+	ROMFamily string
+	// ROMDate is the release date of the system ROM. Set to the zero time if the version does not
+	// contain a date.
+	ROMDate time.Time
+	// RedundantROMVersion is the version of the redundant system ROM. Empty if the system does not
+	// have a redundant system ROM.
+	RedundantROMVersion string
+}
+
+// OIDs defined by the HP MIB that describe the assets of the system.
+const (
+	cpqSiFormFactor         OID = "1.3.6.1.4.1.232.2.2.2.2"
+	cpqSiAssetTag           OID = "1.3.6.1.4.1.232.2.2.2.3"
+	cpqSiProductID          OID = "1.3.6.1.4.1.232.2.2.2.6"
+	cpqSiSystemID           OID = "1.3.6.1.4.1.232.2.2.4.17"
+	cpqSeSysROMVer          OID = "1.3.6.1.4.1.232.1.2.6.1"
+	cpqSeRedundantSysROMVer OID = "1.3.6.1.4.1.232.1.2.6.4"
+)
+
+var (
+	// romFamilyPattern matches the family in the version of a system ROM, e.g. "05/05/2011, Family P67".
+	romFamilyPattern = regexp.MustCompile(`Family\s+(\w+)`)
+)
+
+// SystemInfo returns the asset information of the server, including the versions of its system ROMs.
+// Returns a non-nil error if the asset information could not be determined.
+func (m *MIB) SystemInfo() (SystemInfo, error) {
+	serialNo, err := m.SerialNumber()
+	if err != nil {
+		return SystemInfo{}, err
+	}
+	model, err := m.Model()
+	if err != nil {
+		return SystemInfo{}, err
+	}
+	values, err := getScalars(m.snmpClient, OIDList{
+		cpqSiProductID,
+		cpqSiAssetTag,
+		cpqHoGUIDCanonical,
+		cpqSiFormFactor,
+		cpqSiSystemID,
+		cpqSeSysROMVer,
+		cpqSeRedundantSysROMVer,
+	})
+	if err != nil {
+		return SystemInfo{}, err
+	}
+	formFactor, err := parseScalarInt(values[3])
+	if err != nil {
+		return SystemInfo{}, err
+	}
+	romVersion := prettifyString(values[5])
+	return SystemInfo{
+		SerialNumber:        serialNo,
+		Model:               model,
+		ProductID:           prettifyString(values[0]),
+		AssetTag:            prettifyString(values[1]),
+		UUID:                prettifyString(values[2]),
+		FormFactor:          formFactor,
+		SystemID:            prettifyString(values[4]),
+		ROMVersion:          romVersion,
+		ROMFamily:           parseROMFamily(romVersion),
+		ROMDate:             parseFirmwareDate(romVersion),
+		RedundantROMVersion: prettifyString(values[6]),
+	}, nil
+}
+
+// parseROMFamily extracts the family from the version of a system ROM. Versions that do not name
+// the family explicitly start with it, e.g. "P89 v2.40 (02/17/2017)". Returns an empty string if
+// the version does not contain the family.
+func parseROMFamily(version string) string {
+	match := romFamilyPattern.FindStringSubmatch(version)
+	if match != nil {
+		return match[1]
+	}
+	fields := strings.Fields(version)
+	if len(fields) == 0 || !unicode.IsLetter(rune(fields[0][0])) {
+		return ""
+	}
+	return fields[0]
+}
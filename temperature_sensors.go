@@ -10,7 +10,9 @@ type TemperatureSensorLocale int
 // TemperatureSensorThresholdType describes the type of threshold associated with the temperature sensor.
 type TemperatureSensorThresholdType int
 
-// TemperatureSensor models a temperature sensor in the HP MIB.
+// TemperatureSensor models a temperature sensor in the HP MIB. The HP MIB reports a single threshold
+// for each temperature sensor, whose type is given by ThresholdType. Location is empty if it is not
+// reported by the agent.
 type TemperatureSensor struct {
 	ID                    int
 	CurrentReadingCelsius int
@@ -18,6 +20,7 @@ type TemperatureSensor struct {
 	Status                Status
 	Threshold             int
 	ThresholdType         TemperatureSensorThresholdType
+	Location              string
 }
 
 // Locales for a temperature sensor defined by the HP MIB.
@@ -55,6 +58,8 @@ const (
 	cpqHeTemperatureThreshold     = "1.3.6.1.4.1.232.6.2.6.8.1.5"
 	cpqHeTemperatureCondition     = "1.3.6.1.4.1.232.6.2.6.8.1.6"
 	cpqHeTemperatureThresholdType = "1.3.6.1.4.1.232.6.2.6.8.1.7"
+	cpqHeTemperatureHwLocation    = "1.3.6.1.4.1.232.6.2.6.8.1.8"
+	cpqHeTemperatureChassis       = "1.3.6.1.4.1.232.6.2.6.8.1.1"
 )
 
 // TemperatureSensors returns a list of Temperature Sensors. Returns a non-nil error if the list of Temperature
@@ -69,42 +74,71 @@ func (m *MIB) TemperatureSensors() ([]TemperatureSensor, error) {
 		cpqHeTemperatureThreshold,
 		cpqHeTemperatureCondition,
 		cpqHeTemperatureThresholdType,
+		cpqHeTemperatureChassis,
 	}
 	table, err := traverseTable(m.snmpClient, columns)
 	if err != nil {
 		return []TemperatureSensor{}, err
 	}
 
+	// The hardware location is only reported by newer agents, so this column is walked separately.
+	locations, err := traverseColumn(m.snmpClient, cpqHeTemperatureHwLocation)
+	if err != nil {
+		return []TemperatureSensor{}, err
+	}
+
 	for _, row := range table {
-		index, err := strconv.Atoi(row[0])
+		sensor, err := parseTemperatureSensor(row, locations)
 		if err != nil {
 			return []TemperatureSensor{}, err
 		}
-		locale := parseTemperatureSensorLocale(row[1])
-		celsius, err := strconv.Atoi(row[2])
-		if err != nil {
-			return []TemperatureSensor{}, err
-		}
-		threshold, err := strconv.Atoi(row[3])
-		if err != nil {
-			return []TemperatureSensor{}, err
-		}
-		status := parseStatus(row[4])
-		thresholdType := parseTemperatureSensorThresholdType(row[5])
-
-		sensors = append(sensors, TemperatureSensor{
-			ID:                    index,
-			Locale:                locale,
-			CurrentReadingCelsius: celsius,
-			Threshold:             threshold,
-			Status:                status,
-			ThresholdType:         thresholdType,
-		})
+		sensors = append(sensors, sensor)
 	}
 
 	return sensors, nil
 }
 
+// parseTemperatureSensor parses a row of the temperature table, whose columns are ordered as in
+// TemperatureSensors, and looks up its hardware location by chassis and sensor index.
+func parseTemperatureSensor(row []string, locations map[string]string) (TemperatureSensor, error) {
+	index, err := strconv.Atoi(row[0])
+	if err != nil {
+		return TemperatureSensor{}, err
+	}
+	locale := parseTemperatureSensorLocale(row[1])
+	celsius, err := strconv.Atoi(row[2])
+	if err != nil {
+		return TemperatureSensor{}, err
+	}
+	threshold, err := strconv.Atoi(row[3])
+	if err != nil {
+		return TemperatureSensor{}, err
+	}
+	status := parseStatus(row[4])
+	thresholdType := parseTemperatureSensorThresholdType(row[5])
+	location := prettifyString(locations[row[6]+"."+row[0]])
+
+	return TemperatureSensor{
+		ID:                    index,
+		Locale:                locale,
+		CurrentReadingCelsius: celsius,
+		Threshold:             threshold,
+		Status:                status,
+		ThresholdType:         thresholdType,
+		Location:              location,
+	}, nil
+}
+
+// HeadroomCelsius returns the number of degrees Celsius the current reading of this temperature sensor
+// is below its threshold, which is negative if the threshold has been exceeded. The second return value
+// is false if the temperature sensor does not report a threshold.
+func (t *TemperatureSensor) HeadroomCelsius() (int, bool) {
+	if t.Threshold <= 0 {
+		return 0, false
+	}
+	return t.Threshold - t.CurrentReadingCelsius, true
+}
+
 func parseTemperatureSensorLocale(s string) TemperatureSensorLocale {
 	switch s {
 	case "1":
@@ -2,6 +2,7 @@ package hpmib
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 
@@ -33,14 +34,11 @@ traverse:
 			if !strings.HasPrefix(v.Name, "."+rootOIDs[i]) {
 				break traverse
 			}
-			switch v.Type {
-			case gosnmp.OctetString:
-				row = append(row, string(v.Value.([]byte)))
-			case gosnmp.Integer:
-				row = append(row, strconv.Itoa(v.Value.(int)))
-			default:
-				return [][]string{}, fmt.Errorf("unknown variable type encountered for OID %s", v.Name)
+			value, err := formatVariable(v)
+			if err != nil {
+				return [][]string{}, err
 			}
+			row = append(row, value)
 		}
 		if len(row) != len(columns) {
 			return [][]string{}, fmt.Errorf("expected number of columns in the row %d to equal the number of columns %d in the table", len(row), len(columns))
@@ -51,7 +49,126 @@ traverse:
 	return table, nil
 }
 
+// traverseColumn traverses a single column of a table by issuing multiple GETNEXT requests to the
+// SNMP agent and returns the value of each row keyed by the index of the row, e.g. "0.1". Returns an
+// empty map if the column is not reported by the agent.
+func traverseColumn(client *gosnmp.GoSNMP, column OID) (map[string]string, error) {
+	values := map[string]string{}
+	prefix := "." + string(column) + "."
+	currentOID := string(column)
+	for {
+		res, err := client.GetNext([]string{currentOID})
+		if err != nil {
+			return map[string]string{}, err
+		}
+		if len(res.Variables) != 1 {
+			return map[string]string{}, fmt.Errorf("expected only 1 variable in response but got %d", len(res.Variables))
+		}
+		v := res.Variables[0]
+		if !strings.HasPrefix(v.Name, prefix) {
+			break
+		}
+		value, err := formatVariable(v)
+		if err != nil {
+			return map[string]string{}, err
+		}
+		values[strings.TrimPrefix(v.Name, prefix)] = value
+		currentOID = v.Name
+	}
+	return values, nil
+}
+
+// traverseOptionalColumns traverses each of the provided columns of a table separately with
+// traverseColumn and returns the values of each column keyed by its OID. Columns that are not
+// reported by every agent are walked this way, rather than with traverseTable, so that a missing
+// column does not end the traversal of the table.
+func traverseOptionalColumns(client *gosnmp.GoSNMP, columns OIDList) (map[OID]map[string]string, error) {
+	optional := map[OID]map[string]string{}
+	for _, column := range columns {
+		values, err := traverseColumn(client, column)
+		if err != nil {
+			return map[OID]map[string]string{}, err
+		}
+		optional[column] = values
+	}
+	return optional, nil
+}
+
+// getScalars fetches the scalar objects with the provided OIDs in a single GETNEXT request to the
+// SNMP agent. The value of each object that is not reported by the agent is set to an empty string.
+func getScalars(client *gosnmp.GoSNMP, oids OIDList) ([]string, error) {
+	res, err := client.GetNext(oids.Strings())
+	if err != nil {
+		return []string{}, err
+	}
+	if len(res.Variables) != len(oids) {
+		return []string{}, fmt.Errorf("expected number of variables in the response %d to equal the number of OIDs %d", len(res.Variables), len(oids))
+	}
+	values := make([]string, 0, len(oids))
+	for i, v := range res.Variables {
+		if !strings.HasPrefix(v.Name, "."+string(oids[i])+".") {
+			values = append(values, "")
+			continue
+		}
+		value, err := formatVariable(v)
+		if err != nil {
+			return []string{}, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// formatVariable converts the value of an SNMP variable to a string.
+func formatVariable(v gosnmp.SnmpPDU) (string, error) {
+	switch v.Type {
+	case gosnmp.OctetString:
+		return string(v.Value.([]byte)), nil
+	case gosnmp.Integer:
+		return strconv.Itoa(v.Value.(int)), nil
+	case gosnmp.Counter32, gosnmp.Gauge32, gosnmp.TimeTicks:
+		return strconv.FormatUint(uint64(v.Value.(uint)), 10), nil
+	case gosnmp.Counter64:
+		return strconv.FormatUint(v.Value.(uint64), 10), nil
+	default:
+		return "", fmt.Errorf("unknown variable type encountered for OID %s", v.Name)
+	}
+}
+
+// parseOptionalInt parses the value of the row with the provided index in a column returned by
+// traverseColumn. Returns -1 if the column is not reported for the row or if the value is not available.
+func parseOptionalInt(column map[string]string, index string) (int, error) {
+	s, ok := column[index]
+	if !ok {
+		return -1, nil
+	}
+	return parseScalarInt(s)
+}
+
+// parseScalarInt parses the value of a scalar returned by getScalars. Returns -1 if the scalar is not
+// reported by the agent or if the value is not available.
+func parseScalarInt(s string) (int, error) {
+	if s == "" {
+		return -1, nil
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return -1, err
+	}
+	// Counters and gauges are set to their maximum value if the value is not available.
+	if n == math.MaxUint32 {
+		return -1, nil
+	}
+	return int(n), nil
+}
+
 // prettifyString removes redundant whitespace characters from a string.
 func prettifyString(s string) string {
 	return strings.TrimSpace(strings.Join(strings.Fields(s), " "))
 }
+
+// counter32Delta returns the difference between two samples of a Counter32, accounting for the
+// counter wrapping around at 2^32 between the samples.
+func counter32Delta(previous, current uint32) uint32 {
+	return current - previous
+}